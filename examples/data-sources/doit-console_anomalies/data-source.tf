@@ -0,0 +1,5 @@
+# Cost anomalies detected in October 2023
+data "doit-console_anomalies" "october" {
+  start_time = "2023-10-01T00:00:00Z"
+  end_time   = "2023-11-01T00:00:00Z"
+}
@@ -0,0 +1,9 @@
+# Look up an attribution by name
+data "doit-console_attribution" "by_name" {
+  name = "Team A"
+}
+
+# Look up an attribution by ID
+data "doit-console_attribution" "by_id" {
+  id = "1CE699ZdwN5CRBw0tInY"
+}
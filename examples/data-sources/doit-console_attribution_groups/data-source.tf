@@ -0,0 +1,4 @@
+# List the attribution groups owned by a user
+data "doit-console_attribution_groups" "mine" {
+  owner = "me@example.com"
+}
@@ -0,0 +1,5 @@
+# List the custom attributions whose name starts with "team-"
+data "doit-console_attributions" "teams" {
+  name_prefix = "team-"
+  type        = "custom"
+}
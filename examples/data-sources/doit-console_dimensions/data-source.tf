@@ -0,0 +1,5 @@
+# List the label keys with their values, to use as attribution components
+data "doit-console_dimensions" "labels" {
+  type           = "label"
+  include_values = true
+}
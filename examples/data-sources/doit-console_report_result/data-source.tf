@@ -0,0 +1,33 @@
+# Run an existing report
+data "doit-console_report_result" "existing" {
+  report_id = doit-console_report.my-report.id
+}
+
+# Run an inline report configuration
+data "doit-console_report_result" "last_month" {
+  config = {
+    metric = {
+      type  = "basic"
+      value = "cost"
+    }
+    include_promotional_credits = false
+    advanced_analysis = {
+      trending_up   = false
+      trending_down = false
+      not_trending  = false
+      forecast      = false
+    }
+    aggregation   = "total"
+    time_interval = "month"
+    time_range = {
+      mode            = "last"
+      amount          = 1
+      include_current = false
+      unit            = "month"
+    }
+  }
+}
+
+output "last_month_cost" {
+  value = data.doit-console_report_result.last_month.rows[0].numbers[1]
+}
@@ -0,0 +1,5 @@
+# List the custom reports
+data "doit-console_reports" "custom" {
+  type        = "custom"
+  max_results = 100
+}
@@ -0,0 +1,2 @@
+# Alert can be imported by specifying its ID.
+terraform import doit-console_alert.example <alert_id>
@@ -0,0 +1,17 @@
+# Alert when the daily cost of an attribution exceeds 1000 USD
+resource "doit-console_alert" "daily_cost" {
+  name       = "Team A daily cost"
+  recipients = ["finops@example.com"]
+  config = {
+    metric = {
+      type  = "basic"
+      value = "cost"
+    }
+    condition     = "value"
+    operator      = "gt"
+    value         = 1000
+    time_interval = "day"
+    currency      = "USD"
+    attributions  = [doit-console_attribution.attri.id]
+  }
+}
@@ -0,0 +1,2 @@
+# Attribution can be imported by specifying its ID.
+terraform import doit-console_attribution.example <attribution_id>
@@ -0,0 +1,2 @@
+# Attribution group can be imported by specifying its ID.
+terraform import doit-console_attribution_group.example <attribution_group_id>
@@ -0,0 +1,2 @@
+# Attribution group membership can be imported by specifying the ID of the group and the ID of the attribution.
+terraform import doit-console_attribution_group_membership.example <attribution_group_id>/<attribution_id>
@@ -0,0 +1,12 @@
+# Add the attribution of a team to the "Teams" attribution group owned by
+# the platform team
+resource "doit-console_attribution_group_membership" "team" {
+  attribution_group_id = "BSQZmvX6hvuKGPDHX7R3"
+  attribution_id       = doit-console_attribution.team.id
+}
+
+# The platform team declares the group without its attributions, which are
+# left to the memberships
+resource "doit-console_attribution_group" "teams" {
+  name = "Teams"
+}
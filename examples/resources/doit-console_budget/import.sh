@@ -0,0 +1,2 @@
+# Budget can be imported by specifying its ID.
+terraform import doit-console_budget.example <budget_id>
@@ -0,0 +1,16 @@
+# Monthly recurring budget with two alert thresholds
+resource "doit-console_budget" "team_a" {
+  name          = "Team A monthly budget"
+  description   = "Cloud spend of Team A"
+  scope         = [doit-console_attribution.attri.id]
+  amount        = 10000
+  currency      = "USD"
+  type          = "recurring"
+  time_interval = "month"
+  start_period  = 1696118400000
+  alerts = [
+    { percentage = 80 },
+    { percentage = 100 },
+  ]
+  recipients = ["finops@example.com"]
+}
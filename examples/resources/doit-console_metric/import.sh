@@ -0,0 +1,2 @@
+# Metric can be imported by specifying its ID.
+terraform import doit-console_metric.example <metric_id>
@@ -0,0 +1,29 @@
+# Cost per usage unit of an attribution
+resource "doit-console_metric" "unit_cost" {
+  name        = "Unit cost"
+  description = "Cost per usage unit"
+  formula     = "A / B"
+  format      = "currency"
+  variables = [
+    { metric = "cost", attribution = doit-console_attribution.attri.id },
+    { metric = "usage", attribution = doit-console_attribution.attri.id },
+  ]
+}
+
+# Use the metric in a report
+resource "doit-console_report" "unit_cost" {
+  name = "Unit cost"
+  config = {
+    metric = {
+      type  = "custom"
+      value = doit-console_metric.unit_cost.id
+    }
+    include_promotional_credits = false
+    advanced_analysis = {
+      trending_up   = false
+      trending_down = false
+      not_trending  = false
+      forecast      = false
+    }
+  }
+}
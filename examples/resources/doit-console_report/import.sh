@@ -0,0 +1,2 @@
+# Report can be imported by specifying its ID.
+terraform import doit-console_report.example <report_id>
@@ -0,0 +1,2 @@
+# Report schedule can be imported by specifying the ID of its report.
+terraform import doit-console_report_schedule.example <report_id>
@@ -0,0 +1,11 @@
+# Send the report every Monday at 8:00 Madrid time
+resource "doit-console_report_schedule" "weekly" {
+  report_id  = doit-console_report.my-report.id
+  frequency  = "custom"
+  schedule   = "0 8 * * 1"
+  time_zone  = "Europe/Madrid"
+  recipients = ["managers@example.com"]
+  subject    = "Weekly cloud costs"
+  body       = "Please find attached the cloud costs of last week."
+  format     = "pdf"
+}
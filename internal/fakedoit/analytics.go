@@ -0,0 +1,408 @@
+package fakedoit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// serveAnalytics serves the analytics v1 endpoints. segments is the request
+// path after /analytics/v1. s.mu must be held.
+func (s *Server) serveAnalytics(w http.ResponseWriter, r *http.Request, c *customer, segments []string) {
+	collection := segments[0]
+	if collection == "dimension" && len(segments) == 1 && r.Method == http.MethodGet {
+		s.getDimension(w, r, c)
+		return
+	}
+	if collection == Anomalies || collection == ReportSchedules || collection == Dimensions && (len(segments) != 1 || r.Method != http.MethodGet) {
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+	objects, ok := c.objects[collection]
+	if !ok {
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+
+	switch {
+	case len(segments) == 2 && collection == Reports && segments[1] == "query" && r.Method == http.MethodPost:
+		s.queryReport(w, r)
+	case len(segments) == 2 && collection == Reports && r.Method == http.MethodGet:
+		s.runReport(w, c, segments[1])
+	case len(segments) == 3 && collection == Reports && segments[2] == "schedule":
+		s.serveReportSchedule(w, r, c, segments[1])
+	case len(segments) == 1 && r.Method == http.MethodGet:
+		s.list(w, r, collection, objects)
+	case len(segments) == 1 && r.Method == http.MethodPost:
+		s.create(w, r, c, collection)
+	case len(segments) == 2 && collection != Reports && r.Method == http.MethodGet:
+		s.get(w, c, collection, segments[1])
+	case len(segments) == 3 && collection == Reports && segments[2] == "config" && r.Method == http.MethodGet:
+		s.get(w, c, collection, segments[1])
+	case len(segments) == 2 && r.Method == http.MethodPatch:
+		s.update(w, r, c, collection, segments[1])
+	case len(segments) == 2 && r.Method == http.MethodDelete:
+		if _, ok := objects[segments[1]]; !ok {
+			writeError(w, http.StatusNotFound, collection+" "+segments[1]+" not found")
+			return
+		}
+		delete(objects, segments[1])
+		if collection == Reports {
+			delete(c.objects[ReportSchedules], segments[1])
+		}
+		writeJSON(w, http.StatusOK, object{})
+	default:
+		writeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+// create stores the object in the request body under a new id and returns it.
+func (s *Server) create(w http.ResponseWriter, r *http.Request, c *customer, collection string) {
+	obj, ok := decodeObject(w, r)
+	if !ok {
+		return
+	}
+	if !s.validate(w, c, collection, obj) {
+		return
+	}
+	if name, _ := obj["name"].(string); name == "" {
+		writeError(w, http.StatusBadRequest, "name is required")
+		return
+	}
+
+	now := s.Now().UnixMilli()
+	obj["id"] = s.newID()
+	obj["createTime"] = now
+	obj["updateTime"] = now
+	obj["owner"] = s.Owner
+	if _, ok := obj["type"]; !ok && hasPresetType(collection) {
+		obj["type"] = "custom"
+	}
+	c.objects[collection][obj["id"].(string)] = obj
+
+	writeJSON(w, http.StatusCreated, obj)
+}
+
+// update merges the fields of the request body into the stored object,
+// removing the fields set to null, and returns it.
+func (s *Server) update(w http.ResponseWriter, r *http.Request, c *customer, collection, id string) {
+	stored, ok := c.objects[collection][id]
+	if !ok {
+		writeError(w, http.StatusNotFound, collection+" "+id+" not found")
+		return
+	}
+	patch, ok := decodeObject(w, r)
+	if !ok {
+		return
+	}
+	if !s.validate(w, c, collection, patch) {
+		return
+	}
+
+	for key, value := range patch {
+		switch {
+		case key == "id", key == "owner", key == "createTime", key == "updateTime":
+			// Read only fields.
+		case key == "type" && hasPresetType(collection):
+			// Either preset or custom, set by the API.
+		case value == nil:
+			// Cleared.
+			delete(stored, key)
+		default:
+			stored[key] = value
+		}
+	}
+	stored["updateTime"] = s.Now().UnixMilli()
+
+	writeJSON(w, http.StatusOK, stored)
+}
+
+// get returns a stored object.
+func (s *Server) get(w http.ResponseWriter, c *customer, collection, id string) {
+	obj, ok := c.objects[collection][id]
+	if !ok {
+		writeError(w, http.StatusNotFound, collection+" "+id+" not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, s.view(c, collection, obj))
+}
+
+// serveReportSchedule serves the schedule endpoints of a report. A report
+// has at most one schedule.
+func (s *Server) serveReportSchedule(w http.ResponseWriter, r *http.Request, c *customer, reportID string) {
+	if _, ok := c.objects[Reports][reportID]; !ok {
+		writeError(w, http.StatusNotFound, Reports+" "+reportID+" not found")
+		return
+	}
+	schedules := c.objects[ReportSchedules]
+	schedule, exists := schedules[reportID]
+	if !exists && r.Method != http.MethodPost {
+		writeError(w, http.StatusNotFound, "schedule of report "+reportID+" not found")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, copyObject(schedule))
+	case http.MethodPost:
+		if exists {
+			writeError(w, http.StatusConflict, "report "+reportID+" already has a schedule")
+			return
+		}
+		schedule, ok := decodeObject(w, r)
+		if !ok {
+			return
+		}
+		if frequency, _ := schedule["frequency"].(string); frequency == "" {
+			writeError(w, http.StatusBadRequest, "frequency is required")
+			return
+		}
+		schedule["updateTime"] = s.Now().UnixMilli()
+		schedules[reportID] = schedule
+		writeJSON(w, http.StatusCreated, schedule)
+	case http.MethodPatch:
+		patch, ok := decodeObject(w, r)
+		if !ok {
+			return
+		}
+		for key, value := range patch {
+			if value == nil {
+				delete(schedule, key)
+			} else {
+				schedule[key] = value
+			}
+		}
+		schedule["updateTime"] = s.Now().UnixMilli()
+		writeJSON(w, http.StatusOK, schedule)
+	case http.MethodDelete:
+		delete(schedules, reportID)
+		writeJSON(w, http.StatusOK, object{})
+	default:
+		writeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+// runReport returns the result of a stored report, its "result" field.
+func (s *Server) runReport(w http.ResponseWriter, c *customer, id string) {
+	report, ok := c.objects[Reports][id]
+	if !ok {
+		writeError(w, http.StatusNotFound, Reports+" "+id+" not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, object{
+		"id":         id,
+		"reportName": report["name"],
+		"result":     reportResult(report["result"]),
+	})
+}
+
+// queryReport returns QueryResult for a report configuration.
+func (s *Server) queryReport(w http.ResponseWriter, r *http.Request) {
+	query, ok := decodeObject(w, r)
+	if !ok {
+		return
+	}
+	if _, ok := query["config"].(map[string]any); !ok {
+		writeError(w, http.StatusBadRequest, "config is required")
+		return
+	}
+	writeJSON(w, http.StatusOK, object{"result": reportResult(s.QueryResult)})
+}
+
+// reportResult returns the result of a report run, empty when result is not
+// set.
+func reportResult(result any) any {
+	if result, ok := result.(map[string]any); ok && result != nil {
+		return copyObject(result)
+	}
+	return object{"schema": []any{}, "rows": []any{}, "cacheHit": false}
+}
+
+// getDimension returns the dimension identified by the type and id query
+// parameters, with its values.
+func (s *Server) getDimension(w http.ResponseWriter, r *http.Request, c *customer) {
+	query := r.URL.Query()
+	dimension, ok := c.objects[Dimensions][dimensionKey(query.Get("type"), query.Get("id"))]
+	if !ok {
+		writeError(w, http.StatusNotFound, "dimension "+query.Get("type")+" "+query.Get("id")+" not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, copyObject(dimension))
+}
+
+// list returns a page of object summaries, honoring the maxResults,
+// pageToken, filter, minCreationTime and maxCreationTime query parameters.
+func (s *Server) list(w http.ResponseWriter, r *http.Request, collection string, objects map[string]object) {
+	query := r.URL.Query()
+	filters := map[string]string{}
+	if filter := query.Get("filter"); filter != "" {
+		for _, f := range strings.Split(filter, "|") {
+			key, value, _ := strings.Cut(f, ":")
+			filters[key] = value
+		}
+	}
+	minCreationTime, _ := strconv.ParseInt(query.Get("minCreationTime"), 10, 64)
+	maxCreationTime, _ := strconv.ParseInt(query.Get("maxCreationTime"), 10, 64)
+
+	ids := make([]string, 0, len(objects))
+	for id := range objects {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	items := []object{}
+	for _, id := range ids {
+		item := summary(collection, objects[id])
+		createTime := int64Field(objects[id], creationTimeField(collection))
+		if minCreationTime > 0 && createTime < minCreationTime {
+			continue
+		}
+		if maxCreationTime > 0 && createTime > maxCreationTime {
+			continue
+		}
+		if !matchFilters(item, filters) {
+			continue
+		}
+		items = append(items, item)
+	}
+
+	start, _ := strconv.Atoi(query.Get("pageToken"))
+	if start > len(items) {
+		start = len(items)
+	}
+	end := len(items)
+	if maxResults, _ := strconv.Atoi(query.Get("maxResults")); maxResults > 0 && start+maxResults < end {
+		end = start + maxResults
+	}
+
+	page := object{
+		listKey(collection): items[start:end],
+		"rowCount":          len(items),
+	}
+	if end < len(items) {
+		page["pageToken"] = strconv.Itoa(end)
+	}
+	writeJSON(w, http.StatusOK, page)
+}
+
+// validate checks the references of an attribution group to attributions.
+func (s *Server) validate(w http.ResponseWriter, c *customer, collection string, obj object) bool {
+	if collection != AttributionGroups {
+		return true
+	}
+	attributions, _ := obj["attributions"].([]any)
+	for _, attribution := range attributions {
+		id, _ := attribution.(string)
+		if _, ok := c.objects[Attributions][id]; !ok {
+			writeError(w, http.StatusBadRequest, "attribution "+id+" not found")
+			return false
+		}
+	}
+	return true
+}
+
+// view returns the representation of an object returned by the get
+// endpoints. The attribution groups embed their attributions instead of
+// their ids.
+func (s *Server) view(c *customer, collection string, obj object) object {
+	view := copyObject(obj)
+	if collection != AttributionGroups {
+		return view
+	}
+	ids, _ := view["attributions"].([]any)
+	attributions := []any{}
+	for _, id := range ids {
+		id, _ := id.(string)
+		if attribution, ok := c.objects[Attributions][id]; ok {
+			attributions = append(attributions, copyObject(attribution))
+		} else {
+			attributions = append(attributions, object{"id": id})
+		}
+	}
+	view["attributions"] = attributions
+	return view
+}
+
+// summary returns the representation of an object in a list. The anomalies
+// are listed with all their fields.
+func summary(collection string, obj object) object {
+	if collection == Anomalies {
+		return copyObject(obj)
+	}
+	item := object{}
+	for _, key := range []string{"id", "name", "label", "description", "owner", "type", "createTime", "updateTime"} {
+		if value, ok := obj[key]; ok {
+			item[key] = value
+		}
+	}
+	if collection == Reports {
+		item["reportName"] = item["name"]
+		delete(item, "name")
+	}
+	return item
+}
+
+// hasPresetType reports whether the objects of collection have a type set by
+// the API, either "preset" or "custom", rather than by the client.
+func hasPresetType(collection string) bool {
+	switch collection {
+	case Attributions, AttributionGroups, Reports:
+		return true
+	}
+	return false
+}
+
+// creationTimeField returns the field of the objects of collection compared
+// with the minCreationTime and maxCreationTime query parameters.
+func creationTimeField(collection string) string {
+	if collection == Anomalies {
+		return "startTime"
+	}
+	return "createTime"
+}
+
+// dimensionKey returns the key of a dimension in the Dimensions collection.
+func dimensionKey(dimensionType any, id string) string {
+	return fmt.Sprintf("%v:%s", dimensionType, id)
+}
+
+// listKey returns the key of the items in a list response.
+func listKey(collection string) string {
+	if collection == AttributionGroups {
+		return "attributionGroups"
+	}
+	return collection
+}
+
+func matchFilters(item object, filters map[string]string) bool {
+	for key, value := range filters {
+		if field, _ := item[key].(string); field != value {
+			return false
+		}
+	}
+	return true
+}
+
+func int64Field(obj object, key string) int64 {
+	switch v := obj[key].(type) {
+	case int64:
+		return v
+	case float64:
+		return int64(v)
+	}
+	return 0
+}
+
+// decodeObject decodes the JSON object in the request body, answering with
+// an error when it is not valid.
+func decodeObject(w http.ResponseWriter, r *http.Request) (object, bool) {
+	obj := object{}
+	if err := json.NewDecoder(r.Body).Decode(&obj); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return nil, false
+	}
+	return obj, true
+}
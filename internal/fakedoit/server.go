@@ -0,0 +1,319 @@
+// Package fakedoit implements an in-process fake of the DoiT API for unit and
+// acceptance testing of the provider.
+//
+// The fake serves the analytics v1 attributions, attribution groups, reports,
+// report schedules, runs and queries, budgets, metrics, alerts and dimensions
+// endpoints, and the anomalies v1 list endpoint, from in-memory state. Objects
+// are scoped by the customerContext query parameter, every request must carry
+// the token the server was created with, and faults can be injected to
+// simulate API errors.
+package fakedoit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Collections served by the fake.
+const (
+	Attributions      = "attributions"
+	AttributionGroups = "attributiongroups"
+	Reports           = "reports"
+	Budgets           = "budgets"
+	Metrics           = "metrics"
+	Alerts            = "alerts"
+	// ReportSchedules are served by the schedule endpoints of the reports,
+	// they are identified by the id of their report.
+	ReportSchedules = "reportschedules"
+	// Anomalies are read-only in the API and served by the anomalies v1
+	// list endpoint, they are stored with Put.
+	Anomalies = "anomalies"
+	// Dimensions are read-only in the API, they are stored with Put and
+	// identified by their type and id.
+	Dimensions = "dimensions"
+)
+
+// Server is an in-process fake of the DoiT API.
+type Server struct {
+	*httptest.Server
+
+	// Token is the API token expected in the Authorization header.
+	Token string
+	// Now returns the time used for createTime and updateTime.
+	Now func() time.Time
+	// Owner is the email address set as the owner of the created objects.
+	Owner string
+	// QueryResult is the result returned by the report queries. The runs of
+	// the stored reports return their "result" field.
+	QueryResult map[string]any
+
+	mu        sync.Mutex
+	customers map[string]*customer
+	faults    []*Fault
+	requests  []Request
+	lastID    int
+}
+
+// customer holds the objects of a customerContext, by collection and id.
+type customer struct {
+	objects map[string]map[string]object
+}
+
+// object is the JSON representation of a stored object.
+type object map[string]any
+
+// Fault makes the server answer the matching requests with an error
+// instead of serving them.
+type Fault struct {
+	// Method of the requests to fail. Empty matches any method.
+	Method string
+	// Path prefix of the requests to fail, e.g. "/analytics/v1/reports".
+	// Empty matches any path.
+	Path string
+	// Status code of the response. Zero serves the request normally after
+	// Delay.
+	Status int
+	// Body of the response.
+	Body string
+	// Header of the response, e.g. a Retry-After header.
+	Header http.Header
+	// Times is the number of requests to fail. Zero fails every request.
+	Times int
+	// Delay before answering, to simulate a slow API. The request is not
+	// served when the client gives up before the end of the delay.
+	Delay time.Duration
+}
+
+// Request is a request received by the server.
+type Request struct {
+	Method string
+	Path   string
+	Status int
+}
+
+// New starts a fake DoiT API server accepting token. Callers must Close it.
+func New(token string) *Server {
+	s := &Server{
+		Token:     token,
+		Now:       time.Now,
+		Owner:     "owner@example.com",
+		customers: map[string]*customer{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// InjectFault registers a fault. Faults are matched in the order they were
+// injected.
+func (s *Server) InjectFault(fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &fault)
+}
+
+// ClearFaults removes all the injected faults.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// Requests returns the requests received so far.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// Get returns the JSON representation of an object, and whether it exists.
+func (s *Server) Get(customerContext, collection, id string) (map[string]any, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	obj, ok := s.customer(customerContext).objects[collection][id]
+	if !ok {
+		return nil, false
+	}
+	return copyObject(obj), true
+}
+
+// Put stores an object as if it was created in the DoiT console, and
+// returns its id. A new id is generated when obj has none.
+func (s *Server) Put(customerContext, collection string, obj map[string]any) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored := copyObject(obj)
+	id, _ := stored["id"].(string)
+	if id == "" {
+		id = s.newID()
+		stored["id"] = id
+	}
+	key := id
+	if collection == Dimensions {
+		key = dimensionKey(stored["type"], id)
+	}
+	s.customer(customerContext).objects[collection][key] = stored
+	return id
+}
+
+// Delete removes an object as if it was deleted in the DoiT console.
+func (s *Server) Delete(customerContext, collection, id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.customer(customerContext).objects[collection], id)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+	s.serve(rec, r)
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{Method: r.Method, Path: r.URL.Path, Status: rec.status})
+	s.mu.Unlock()
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	if fault := s.matchFault(r); fault != nil {
+		if fault.Delay > 0 {
+			// The cancellation of the request is only noticed once its
+			// body is read.
+			body, _ := io.ReadAll(r.Body)
+			r.Body = io.NopCloser(bytes.NewReader(body))
+		}
+		select {
+		case <-time.After(fault.Delay):
+		case <-r.Context().Done():
+			return
+		}
+		if fault.Status != 0 {
+			for key, values := range fault.Header {
+				w.Header()[key] = values
+			}
+			w.WriteHeader(fault.Status)
+			_, _ = w.Write([]byte(fault.Body))
+			return
+		}
+	}
+
+	if r.Header.Get("Authorization") != "Bearer "+s.Token {
+		writeError(w, http.StatusUnauthorized, "invalid API token")
+		return
+	}
+
+	customerContext := r.URL.Query().Get("customerContext")
+	if customerContext == "" {
+		writeError(w, http.StatusBadRequest, "missing customerContext")
+		return
+	}
+
+	path := strings.Trim(r.URL.Path, "/")
+	if path == "" {
+		// Used by the provider to validate the credentials.
+		writeJSON(w, http.StatusOK, object{})
+		return
+	}
+
+	segments := strings.Split(path, "/")
+	if path == "anomalies/v1" && r.Method == http.MethodGet {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.list(w, r, Anomalies, s.customer(customerContext).objects[Anomalies])
+		return
+	}
+	if len(segments) < 3 || segments[0] != "analytics" || segments[1] != "v1" {
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.serveAnalytics(w, r, s.customer(customerContext), segments[2:])
+}
+
+// matchFault returns the first fault matching r, consuming one of its times.
+func (s *Server) matchFault(r *http.Request) *Fault {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, fault := range s.faults {
+		if fault.Method != "" && fault.Method != r.Method {
+			continue
+		}
+		if !strings.HasPrefix(r.URL.Path, fault.Path) {
+			continue
+		}
+		if fault.Times > 0 {
+			fault.Times--
+			if fault.Times == 0 {
+				s.faults = append(s.faults[:i], s.faults[i+1:]...)
+			}
+		}
+		return fault
+	}
+	return nil
+}
+
+// customer returns the state of customerContext. s.mu must be held.
+func (s *Server) customer(customerContext string) *customer {
+	c, ok := s.customers[customerContext]
+	if !ok {
+		c = &customer{objects: map[string]map[string]object{
+			Attributions:      {},
+			AttributionGroups: {},
+			Reports:           {},
+			ReportSchedules:   {},
+			Budgets:           {},
+			Metrics:           {},
+			Alerts:            {},
+			Anomalies:         {},
+			Dimensions:        {},
+		}}
+		s.customers[customerContext] = c
+	}
+	return c
+}
+
+// newID returns a new object id. s.mu must be held.
+func (s *Server) newID() string {
+	s.lastID++
+	return fmt.Sprintf("fake%016d", s.lastID)
+}
+
+// statusRecorder records the status code written to a response.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, object{"error": message})
+}
+
+// copyObject returns a deep copy of obj.
+func copyObject(obj map[string]any) object {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		panic(err)
+	}
+	copied := object{}
+	if err := json.Unmarshal(b, &copied); err != nil {
+		panic(err)
+	}
+	return copied
+}
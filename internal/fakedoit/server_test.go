@@ -0,0 +1,251 @@
+package fakedoit
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	testToken           = "token"
+	testCustomerContext = "customer"
+)
+
+// get sends a GET request to the server and decodes its JSON response.
+func get(t *testing.T, s *Server, path string, query url.Values) (int, http.Header, map[string]any) {
+	t.Helper()
+	return send(t, s, http.MethodGet, path, query, "")
+}
+
+// send sends a request with a JSON body to the server and decodes its JSON
+// response.
+func send(t *testing.T, s *Server, method, path string, query url.Values, body string) (int, http.Header, map[string]any) {
+	t.Helper()
+	if query == nil {
+		query = url.Values{}
+	}
+	query.Set("customerContext", testCustomerContext)
+	req, err := http.NewRequest(method, s.URL+path+"?"+query.Encode(), strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer "+testToken)
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	decoded := map[string]any{}
+	_ = json.NewDecoder(res.Body).Decode(&decoded)
+	return res.StatusCode, res.Header, decoded
+}
+
+// names returns the names of the attributions of a list response.
+func names(page map[string]any) []string {
+	items, _ := page[Attributions].([]any)
+	var names []string
+	for _, item := range items {
+		name, _ := item.(map[string]any)["name"].(string)
+		names = append(names, name)
+	}
+	return names
+}
+
+func TestListPagination(t *testing.T) {
+	s := New(testToken)
+	defer s.Close()
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		s.Put(testCustomerContext, Attributions, map[string]any{"name": name})
+	}
+
+	var pages [][]string
+	pageToken := ""
+	for {
+		query := url.Values{"maxResults": {"2"}}
+		if pageToken != "" {
+			query.Set("pageToken", pageToken)
+		}
+		status, _, page := get(t, s, "/analytics/v1/attributions", query)
+		if status != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %v", status, page)
+		}
+		if page["rowCount"] != float64(5) {
+			t.Errorf("expected rowCount 5, got %v", page["rowCount"])
+		}
+		pages = append(pages, names(page))
+		pageToken, _ = page["pageToken"].(string)
+		if pageToken == "" {
+			break
+		}
+	}
+	if got := strings.Join(pagesString(pages), " "); got != "a,b c,d e" {
+		t.Errorf("expected the pages a,b c,d e, got %s", got)
+	}
+}
+
+func pagesString(pages [][]string) []string {
+	var s []string
+	for _, page := range pages {
+		s = append(s, strings.Join(page, ","))
+	}
+	return s
+}
+
+func TestListFilters(t *testing.T) {
+	s := New(testToken)
+	defer s.Close()
+	s.Put(testCustomerContext, Attributions, map[string]any{"name": "a", "owner": "x@example.com", "type": "custom", "createTime": 1000})
+	s.Put(testCustomerContext, Attributions, map[string]any{"name": "b", "owner": "y@example.com", "type": "custom", "createTime": 2000})
+	s.Put(testCustomerContext, Attributions, map[string]any{"name": "c", "owner": "x@example.com", "type": "preset", "createTime": 3000})
+	s.Put("other-customer", Attributions, map[string]any{"name": "other", "owner": "x@example.com", "type": "custom"})
+
+	tests := []struct {
+		query    url.Values
+		expected string
+	}{
+		{query: url.Values{}, expected: "a,b,c"},
+		{query: url.Values{"filter": {"owner:x@example.com"}}, expected: "a,c"},
+		{query: url.Values{"filter": {"owner:x@example.com|type:custom"}}, expected: "a"},
+		{query: url.Values{"filter": {"type:preset"}}, expected: "c"},
+		{query: url.Values{"minCreationTime": {"2000"}}, expected: "b,c"},
+		{query: url.Values{"maxCreationTime": {"2000"}}, expected: "a,b"},
+		{query: url.Values{"minCreationTime": {"1500"}, "maxCreationTime": {"2500"}}, expected: "b"},
+	}
+	for _, tt := range tests {
+		status, _, page := get(t, s, "/analytics/v1/attributions", tt.query)
+		if status != http.StatusOK {
+			t.Fatalf("%s: expected status 200, got %d", tt.query.Encode(), status)
+		}
+		if got := strings.Join(names(page), ","); got != tt.expected {
+			t.Errorf("%s: expected %s, got %s", tt.query.Encode(), tt.expected, got)
+		}
+	}
+}
+
+func TestInjectFault(t *testing.T) {
+	s := New(testToken)
+	defer s.Close()
+	id := s.Put(testCustomerContext, Attributions, map[string]any{"name": "a"})
+	path := "/analytics/v1/attributions/" + id
+
+	s.InjectFault(Fault{Method: http.MethodGet, Path: "/analytics/v1/attributions", Status: http.StatusTooManyRequests,
+		Header: http.Header{"Retry-After": {"2"}}, Body: `{"error":"slow down"}`, Times: 2})
+	// Faults of other methods or paths don't match.
+	s.InjectFault(Fault{Method: http.MethodDelete, Status: http.StatusInternalServerError})
+	s.InjectFault(Fault{Path: "/analytics/v1/reports", Status: http.StatusInternalServerError})
+
+	for i := 0; i < 2; i++ {
+		status, header, body := get(t, s, path, nil)
+		if status != http.StatusTooManyRequests || header.Get("Retry-After") != "2" || body["error"] != "slow down" {
+			t.Errorf("request %d: expected the injected fault, got %d %v %v", i, status, header, body)
+		}
+	}
+	// The fault is removed once consumed.
+	status, _, body := get(t, s, path, nil)
+	if status != http.StatusOK || body["name"] != "a" {
+		t.Errorf("expected the request to be served once the fault is consumed, got %d %v", status, body)
+	}
+
+	s.ClearFaults()
+	s.InjectFault(Fault{Path: path, Delay: 10 * time.Millisecond})
+	status, _, _ = get(t, s, path, nil)
+	if status != http.StatusOK {
+		t.Errorf("expected a delayed request to be served, got %d", status)
+	}
+
+	// Every request is recorded with its status, starting with the faults.
+	var statuses []int
+	for _, request := range s.Requests() {
+		if request.Method != http.MethodGet || request.Path != path {
+			t.Errorf("unexpected request %+v", request)
+		}
+		statuses = append(statuses, request.Status)
+	}
+	if len(statuses) != 4 || statuses[0] != 429 || statuses[1] != 429 || statuses[2] != 200 || statuses[3] != 200 {
+		t.Errorf("expected the statuses 429, 429, 200, 200, got %v", statuses)
+	}
+}
+
+func TestAuthentication(t *testing.T) {
+	s := New(testToken)
+	defer s.Close()
+
+	req, err := http.NewRequest(http.MethodGet, s.URL+"/analytics/v1/attributions?customerContext="+testCustomerContext, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", testToken)
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusUnauthorized {
+		t.Errorf("expected a token without Bearer to be rejected, got %d", res.StatusCode)
+	}
+
+	status, _, _ := get(t, s, "/analytics/v1/unknown", nil)
+	if status != http.StatusNotFound {
+		t.Errorf("expected an unknown collection not to be found, got %d", status)
+	}
+}
+
+func TestListAnomalies(t *testing.T) {
+	s := New(testToken)
+	defer s.Close()
+	s.Put(testCustomerContext, Anomalies, map[string]any{"id": "a", "costOfAnomaly": 10.5, "startTime": 1000})
+	s.Put(testCustomerContext, Anomalies, map[string]any{"id": "b", "costOfAnomaly": 20, "startTime": 2000})
+
+	// The creation time bounds apply to the start of the anomalies.
+	status, _, page := get(t, s, "/anomalies/v1", url.Values{"minCreationTime": {"1500"}})
+	if status != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %v", status, page)
+	}
+	items, _ := page[Anomalies].([]any)
+	if len(items) != 1 {
+		t.Fatalf("expected 1 anomaly, got %v", page)
+	}
+	if anomaly := items[0].(map[string]any); anomaly["id"] != "b" || anomaly["costOfAnomaly"] != float64(20) {
+		t.Errorf("expected anomaly b with all its fields, got %v", anomaly)
+	}
+
+	status, _, _ = get(t, s, "/analytics/v1/anomalies", nil)
+	if status != http.StatusNotFound {
+		t.Errorf("expected the anomalies not to be served by the analytics endpoints, got %d", status)
+	}
+}
+
+func TestReportSchedule(t *testing.T) {
+	s := New(testToken)
+	defer s.Close()
+	id := s.Put(testCustomerContext, Reports, map[string]any{"name": "a"})
+	path := "/analytics/v1/reports/" + id + "/schedule"
+
+	if status, _, _ := get(t, s, path, nil); status != http.StatusNotFound {
+		t.Errorf("expected no schedule, got %d", status)
+	}
+	if status, _, _ := send(t, s, http.MethodPost, "/analytics/v1/reports/missing/schedule", nil, `{"frequency":"daily"}`); status != http.StatusNotFound {
+		t.Errorf("expected the schedule of a missing report to be rejected, got %d", status)
+	}
+	if status, _, _ := send(t, s, http.MethodPost, path, nil, `{"frequency":"daily","subject":"costs"}`); status != http.StatusCreated {
+		t.Errorf("expected the schedule to be created, got %d", status)
+	}
+	if status, _, _ := send(t, s, http.MethodPost, path, nil, `{"frequency":"daily"}`); status != http.StatusConflict {
+		t.Errorf("expected a second schedule to be rejected, got %d", status)
+	}
+	status, _, schedule := send(t, s, http.MethodPatch, path, nil, `{"frequency":"weekly","subject":null}`)
+	if _, ok := schedule["subject"]; status != http.StatusOK || schedule["frequency"] != "weekly" || ok {
+		t.Errorf("expected the schedule to be updated and its subject cleared, got %d %v", status, schedule)
+	}
+
+	// The schedule is deleted with its report.
+	if status, _, _ := send(t, s, http.MethodDelete, "/analytics/v1/reports/"+id, nil, ""); status != http.StatusOK {
+		t.Errorf("expected the report to be deleted, got %d", status)
+	}
+	if _, ok := s.Get(testCustomerContext, ReportSchedules, id); ok {
+		t.Errorf("expected the schedule to be deleted with its report")
+	}
+}
@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+)
+
+// CreateAlert - Create new alert
+func (a *AnalyticsClient) CreateAlert(ctx context.Context, alert Alert) (*Alert, error) {
+	return do[Alert](ctx, a.client, http.MethodPost, analyticsPath("alerts"), nil, alert)
+}
+
+// UpdateAlert - Updates an alert, clearing its empty optional fields
+func (a *AnalyticsClient) UpdateAlert(ctx context.Context, alertID string, alert Alert) (*Alert, error) {
+	body, err := patchBody(alert, "recipients", "config.condition", "config.currency", "config.attributions",
+		"config.scopes", "config.evaluateForEach")
+	if err != nil {
+		return nil, err
+	}
+	return do[Alert](ctx, a.client, http.MethodPatch, analyticsPath("alerts", alertID), nil, body)
+}
+
+// DeleteAlert - Deletes an alert
+func (a *AnalyticsClient) DeleteAlert(ctx context.Context, alertID string) error {
+	_, err := do[emptyResponse](ctx, a.client, http.MethodDelete, analyticsPath("alerts", alertID), nil, nil)
+	return err
+}
+
+// GetAlert - Returns a specifc alert
+func (a *AnalyticsClient) GetAlert(ctx context.Context, alertID string) (*Alert, error) {
+	return do[Alert](ctx, a.client, http.MethodGet, analyticsPath("alerts", alertID), nil, nil)
+}
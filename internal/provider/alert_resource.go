@@ -0,0 +1,414 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Values accepted by the DoiT API for the enum fields of an alert.
+var (
+	alertMetricTypes   = []string{"basic", "custom"}
+	alertConditions    = []string{"value", "percentage-change", "forecast"}
+	alertOperators     = []string{"gt", "lt"}
+	alertTimeIntervals = []string{"day", "week", "month", "quarter", "year"}
+)
+
+// alertResourceModel maps the resource schema data.
+type alertResourceModel struct {
+	Id          types.String      `tfsdk:"id"`
+	Name        types.String      `tfsdk:"name"`
+	Config      *alertConfigModel `tfsdk:"config"`
+	Recipients  []types.String    `tfsdk:"recipients"`
+	LastUpdated types.String      `tfsdk:"last_updated"`
+	Timeouts    timeouts.Value    `tfsdk:"timeouts"`
+}
+
+// alertConfigModel maps the alert configuration data.
+type alertConfigModel struct {
+	Metric          *ExternalMetricModel        `tfsdk:"metric"`
+	Condition       types.String                `tfsdk:"condition"`
+	Operator        types.String                `tfsdk:"operator"`
+	Value           types.Float64               `tfsdk:"value"`
+	TimeInterval    types.String                `tfsdk:"time_interval"`
+	Currency        types.String                `tfsdk:"currency"`
+	Attributions    []types.String              `tfsdk:"attributions"`
+	Scopes          []ExternalConfigFilterModel `tfsdk:"scopes"`
+	EvaluateForEach types.String                `tfsdk:"evaluate_for_each"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &alertResource{}
+	_ resource.ResourceWithConfigure   = &alertResource{}
+	_ resource.ResourceWithImportState = &alertResource{}
+)
+
+// NewAlertResource is a helper function to simplify the provider implementation.
+func NewAlertResource() resource.Resource {
+	return &alertResource{}
+}
+
+// alertResource is the resource implementation.
+type alertResource struct {
+	client *ClientTest
+}
+
+// Metadata returns the resource type name.
+func (r *alertResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert"
+}
+
+// Schema defines the schema for the resource.
+func (r *alertResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Cost alert notifying its recipients when a metric crosses a threshold.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the alert",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last update of the alert, in RFC 3339 format",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the alert",
+				Required:    true,
+			},
+			"recipients": schema.ListAttribute{
+				Description: "List of emails to notify when the alert is triggered",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"config": schema.SingleNestedAttribute{
+				Description: "Alert configuration",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"metric": schema.SingleNestedAttribute{
+						Description: "Metric evaluated by the alert",
+						Required:    true,
+						Attributes: map[string]schema.Attribute{
+							"type": schema.StringAttribute{
+								Description: "Type of the metric. " + oneOfDescription(alertMetricTypes),
+								Required:    true,
+								Validators: []validator.String{
+									stringvalidator.OneOf(alertMetricTypes...),
+								},
+							},
+							"value": schema.StringAttribute{
+								Description: "For basic metrics the value can be one of: [\"cost\", \"usage\", \"savings\"] \n" +
+									"If using custom metrics, the value must be the id of a doit-console_metric",
+								Required: true,
+							},
+						},
+					},
+					"condition": schema.StringAttribute{
+						Description: "Condition of the alert. " + oneOfDescription(alertConditions),
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(alertConditions...),
+						},
+					},
+					"operator": schema.StringAttribute{
+						Description: "Operator comparing the metric to the value. " + oneOfDescription(alertOperators),
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(alertOperators...),
+						},
+					},
+					"value": schema.Float64Attribute{
+						Description: "Threshold of the condition",
+						Required:    true,
+					},
+					"time_interval": schema.StringAttribute{
+						Description: "Interval the metric is aggregated on. " + oneOfDescription(alertTimeIntervals),
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(alertTimeIntervals...),
+						},
+					},
+					"currency": schema.StringAttribute{
+						Description: "Currency of the value when the metric is a cost. " + oneOfDescription(reportCurrencies),
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(reportCurrencies...),
+						},
+					},
+					"attributions": schema.ListAttribute{
+						Description: "IDs of the attributions the alert is scoped to",
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+					"scopes": filtersAttribute("Dimension filters the alert is scoped to"),
+					"evaluate_for_each": schema.StringAttribute{
+						Description: "Dimension for whose each value the condition is evaluated",
+						Optional:    true,
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *alertResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ClientTest)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ClientTest, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// toAlert generates the API request body from the model.
+func (m *alertResourceModel) toAlert() Alert {
+	alert := Alert{
+		Id:   m.Id.ValueString(),
+		Name: m.Name.ValueString(),
+	}
+	for _, recipient := range m.Recipients {
+		alert.Recipients = append(alert.Recipients, recipient.ValueString())
+	}
+	if m.Config == nil {
+		return alert
+	}
+
+	alert.Config = AlertConfig{
+		Condition:       m.Config.Condition.ValueString(),
+		Operator:        m.Config.Operator.ValueString(),
+		Value:           m.Config.Value.ValueFloat64(),
+		TimeInterval:    m.Config.TimeInterval.ValueString(),
+		Currency:        m.Config.Currency.ValueString(),
+		Scopes:          externalConfigFilters(m.Config.Scopes),
+		EvaluateForEach: m.Config.EvaluateForEach.ValueString(),
+	}
+	if m.Config.Metric != nil {
+		alert.Config.Metric = &ExternalMetric{
+			Type:  m.Config.Metric.Type.ValueString(),
+			Value: m.Config.Metric.Value.ValueString(),
+		}
+	}
+	for _, attribution := range m.Config.Attributions {
+		alert.Config.Attributions = append(alert.Config.Attributions, attribution.ValueString())
+	}
+	return alert
+}
+
+// fromAlert overwrites the model with the alert returned by the API.
+func (m *alertResourceModel) fromAlert(alert *Alert) {
+	if alert.Id != "" {
+		m.Id = types.StringValue(alert.Id)
+	}
+	m.Name = types.StringValue(alert.Name)
+
+	m.Recipients = emptyList(m.Recipients)
+	for _, recipient := range alert.Recipients {
+		m.Recipients = append(m.Recipients, types.StringValue(recipient))
+	}
+
+	if m.Config == nil {
+		m.Config = &alertConfigModel{}
+	}
+	m.Config.Metric = externalMetricModel(m.Config.Metric, alert.Config.Metric)
+	m.Config.Condition = optionalStringValue(m.Config.Condition, alert.Config.Condition)
+	m.Config.Operator = types.StringValue(alert.Config.Operator)
+	m.Config.Value = types.Float64Value(alert.Config.Value)
+	m.Config.TimeInterval = types.StringValue(alert.Config.TimeInterval)
+	m.Config.Currency = optionalStringValue(m.Config.Currency, alert.Config.Currency)
+	m.Config.Scopes = externalConfigFilterModels(m.Config.Scopes, alert.Config.Scopes)
+	m.Config.EvaluateForEach = optionalStringValue(m.Config.EvaluateForEach, alert.Config.EvaluateForEach)
+	m.Config.Attributions = emptyList(m.Config.Attributions)
+	for _, attribution := range alert.Config.Attributions {
+		m.Config.Attributions = append(m.Config.Attributions, types.StringValue(attribution))
+	}
+	m.LastUpdated = timestampValue(alert.UpdateTime)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *alertResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan alertResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Create new alert
+	alertResponse, err := r.client.Analytics.CreateAlert(ctx, plan.toAlert())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating alert",
+			"Could not create alert, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	plan.Id = types.StringValue(alertResponse.Id)
+	plan.LastUpdated = timestampValue(alertResponse.UpdateTime)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *alertResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state alertResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed alert value from DoiT
+	alert, err := r.client.Analytics.GetAlert(ctx, state.Id.ValueString())
+	if IsNotFound(err) {
+		// The object was deleted outside of Terraform, remove it from
+		// the state so it is planned for creation again.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Doit Console Alert",
+			"Could not read Doit Console Alert ID "+state.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	state.fromAlert(alert)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *alertResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan alertResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state alertResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Update existing alert
+	alert := plan.toAlert()
+	alert.Id = state.Id.ValueString()
+	_, err := r.client.Analytics.UpdateAlert(ctx, state.Id.ValueString(), alert)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating DoiT Alert",
+			"Could not update alert, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Fetch updated items from GetAlert
+	alertResponse, err := r.client.Analytics.GetAlert(ctx, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Doit Console Alert",
+			"Could not read Doit Console alert ID "+state.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update resource state with updated items and timestamp
+	plan.Id = state.Id
+	plan.fromAlert(alertResponse)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ImportState imports an existing alert by its ID.
+func (r *alertResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *alertResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state alertResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing alert
+	err := r.client.Analytics.DeleteAlert(ctx, state.Id.ValueString())
+	if err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting DoiT Alert",
+			"Could not delete alert, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
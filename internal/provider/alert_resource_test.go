@@ -0,0 +1,254 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	"terraform-provider-doit-console/internal/fakedoit"
+)
+
+func TestAccAlertResource(t *testing.T) {
+	server := testAccServer(t)
+	testAccClock(server, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "doit-console_alert", fakedoit.Alerts),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_alert" "test" {
+  name       = "test alert"
+  recipients = []
+  config = {
+    metric = {
+      type  = "basic"
+      value = "cost"
+    }
+    condition     = "value"
+    operator      = "gt"
+    value         = 1000
+    time_interval = "month"
+    currency      = "USD"
+    attributions  = []
+    scopes = [
+      {
+        id      = "cloud_provider"
+        type    = "fixed"
+        inverse = true
+        values  = ["amazon-web-services"]
+      },
+    ]
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("doit-console_alert.test", "id"),
+					resource.TestCheckResourceAttr("doit-console_alert.test", "last_updated", "2024-01-02T03:04:05Z"),
+					resource.TestCheckResourceAttr("doit-console_alert.test", "name", "test alert"),
+					resource.TestCheckResourceAttr("doit-console_alert.test", "recipients.#", "0"),
+					resource.TestCheckResourceAttr("doit-console_alert.test", "config.attributions.#", "0"),
+					resource.TestCheckResourceAttr("doit-console_alert.test", "config.scopes.#", "1"),
+					resource.TestCheckResourceAttr("doit-console_alert.test", "config.scopes.0.values.0", "amazon-web-services"),
+					testAccCheckObject(server, "doit-console_alert.test", fakedoit.Alerts, "name", "test alert"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// ImportState testing. The API omits the empty lists.
+			{
+				ResourceName:            "doit-console_alert.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"recipients", "config.attributions"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_alert" "test" {
+  name       = "test alert updated"
+  recipients = ["finops@example.com"]
+  config = {
+    metric = {
+      type  = "basic"
+      value = "usage"
+    }
+    condition         = "percentage-change"
+    operator          = "lt"
+    value             = 10
+    time_interval     = "week"
+    attributions      = ["attribution-1"]
+    evaluate_for_each = "service_description"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccStoreID("doit-console_alert.test", &id),
+					resource.TestCheckResourceAttr("doit-console_alert.test", "name", "test alert updated"),
+					resource.TestCheckResourceAttr("doit-console_alert.test", "recipients.0", "finops@example.com"),
+					resource.TestCheckResourceAttr("doit-console_alert.test", "config.metric.value", "usage"),
+					resource.TestCheckResourceAttr("doit-console_alert.test", "config.operator", "lt"),
+					resource.TestCheckResourceAttr("doit-console_alert.test", "config.attributions.0", "attribution-1"),
+					resource.TestCheckResourceAttr("doit-console_alert.test", "config.evaluate_for_each", "service_description"),
+					resource.TestCheckNoResourceAttr("doit-console_alert.test", "config.scopes"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("doit-console_alert.test", plancheck.ResourceActionUpdate),
+						testAccExpectKnownValue("doit-console_alert.test", "id"),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Recreate after deletion outside of Terraform testing
+			{
+				PreConfig: testAccDeleteOutOfBand(server, fakedoit.Alerts, &id),
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_alert" "test" {
+  name       = "test alert updated"
+  recipients = ["finops@example.com"]
+  config = {
+    metric = {
+      type  = "basic"
+      value = "usage"
+    }
+    condition         = "percentage-change"
+    operator          = "lt"
+    value             = 10
+    time_interval     = "week"
+    attributions      = ["attribution-1"]
+    evaluate_for_each = "service_description"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIDChanged("doit-console_alert.test", &id),
+					testAccCheckObject(server, "doit-console_alert.test", fakedoit.Alerts, "name", "test alert updated"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("doit-console_alert.test", plancheck.ResourceActionCreate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Removing the optional attributes clears them
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_alert" "test" {
+  name = "test alert updated"
+  config = {
+    metric = {
+      type  = "basic"
+      value = "usage"
+    }
+    operator      = "lt"
+    value         = 10
+    time_interval = "week"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("doit-console_alert.test", "recipients"),
+					resource.TestCheckNoResourceAttr("doit-console_alert.test", "config.condition"),
+					resource.TestCheckNoResourceAttr("doit-console_alert.test", "config.attributions"),
+					resource.TestCheckNoResourceAttr("doit-console_alert.test", "config.evaluate_for_each"),
+					testAccCheckObject(server, "doit-console_alert.test", fakedoit.Alerts, "recipients", nil),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("doit-console_alert.test", plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAlertResource_invalidConfig(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_alert" "test" {
+  name = "test alert"
+  config = {
+    metric        = { type = "basic", value = "cost" }
+    operator      = "gt"
+    value         = 1000
+    time_interval = "monthly"
+  }
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Attribute config.time_interval value must be one of`),
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_alert" "test" {
+  name = "test alert"
+  config = {
+    metric        = { type = "basic", value = "cost" }
+    operator      = "gte"
+    value         = 1000
+    time_interval = "month"
+  }
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Attribute config.operator value must be one of`),
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_alert" "test" {
+  name = "test alert"
+  config = {
+    metric        = { type = "extended", value = "cost" }
+    operator      = "gt"
+    value         = 1000
+    time_interval = "month"
+  }
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Attribute config.metric.type value must be one of`),
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_alert" "test" {
+  name = "test alert"
+  config = {
+    metric        = { type = "basic", value = "cost" }
+    condition     = "threshold"
+    operator      = "gt"
+    value         = 1000
+    time_interval = "month"
+  }
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Attribute config.condition value must be one of`),
+			},
+		},
+	})
+}
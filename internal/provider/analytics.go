@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"net/url"
+	"strings"
+)
+
+// AnalyticsClient groups the endpoints of the DoiT analytics API, such as the
+// attributions, the attribution groups and the reports.
+type AnalyticsClient struct {
+	client *ClientTest
+}
+
+// analyticsPath returns the path of an analytics API endpoint made of
+// segments, escaping each of them as they may contain IDs.
+func analyticsPath(segments ...string) string {
+	escaped := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		escaped = append(escaped, url.PathEscape(segment))
+	}
+	return "/analytics/v1/" + strings.Join(escaped, "/")
+}
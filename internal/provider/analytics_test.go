@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+// recordedRequest is a request received by the test server of
+// TestAnalyticsClientRequests.
+type recordedRequest struct {
+	method string
+	path   string
+	query  string
+	header http.Header
+	body   string
+}
+
+func TestAnalyticsClientRequests(t *testing.T) {
+	var mu sync.Mutex
+	var requests []recordedRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		requests = append(requests, recordedRequest{
+			method: r.Method,
+			path:   r.URL.EscapedPath(),
+			query:  r.URL.RawQuery,
+			header: r.Header,
+			body:   string(body),
+		})
+		mu.Unlock()
+		if r.Method == http.MethodDelete {
+			// The deletions may not return a body.
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"a/b ?c"}`))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	host, token, customerContext := server.URL, testAccToken, "customer&x=y"
+	client, err := NewClientTest(ctx, &host, &token, &customerContext, &RetryConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	updated, err := client.Analytics.UpdateAttribution(ctx, "a/b ?c", Attribution{Name: "escaped"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Id != "a/b ?c" {
+		t.Errorf("expected the response to be decoded, got id %q", updated.Id)
+	}
+	err = client.Analytics.DeleteReportSchedule(ctx, "a/b ?c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.Analytics.GetDimension(ctx, "label", "team&env")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []recordedRequest{
+		{method: http.MethodGet, path: "/", query: "customerContext=customer%26x%3Dy"},
+		{
+			method: http.MethodPatch,
+			path:   "/analytics/v1/attributions/a%2Fb%20%3Fc",
+			query:  "customerContext=customer%26x%3Dy",
+			body:   `{"description":null,"name":"escaped"}`,
+		},
+		{method: http.MethodDelete, path: "/analytics/v1/reports/a%2Fb%20%3Fc/schedule", query: "customerContext=customer%26x%3Dy"},
+		{method: http.MethodGet, path: "/analytics/v1/dimension", query: "customerContext=customer%26x%3Dy&id=team%26env&type=label"},
+	}
+	if len(requests) != len(expected) {
+		t.Fatalf("expected %d requests, got %d: %v", len(expected), len(requests), requests)
+	}
+	for i, request := range requests {
+		want := expected[i]
+		if request.method != want.method || request.path != want.path || request.query != want.query || request.body != want.body {
+			t.Errorf("request %d: expected %s %s?%s %q, got %s %s?%s %q", i,
+				want.method, want.path, want.query, want.body, request.method, request.path, request.query, request.body)
+		}
+		if auth := request.header.Get("Authorization"); auth != "Bearer "+testAccToken {
+			t.Errorf("request %d: unexpected Authorization header %q", i, auth)
+		}
+		if accept := request.header.Get("Accept"); accept != "application/json" {
+			t.Errorf("request %d: unexpected Accept header %q", i, accept)
+		}
+		contentType := ""
+		if want.body != "" {
+			contentType = "application/json"
+		}
+		if got := request.header.Get("Content-Type"); got != contentType {
+			t.Errorf("request %d: expected Content-Type %q, got %q", i, contentType, got)
+		}
+	}
+}
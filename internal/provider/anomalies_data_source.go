@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// anomaliesDataSourceModel maps the data source schema data.
+type anomaliesDataSourceModel struct {
+	StartTime types.String   `tfsdk:"start_time"`
+	EndTime   types.String   `tfsdk:"end_time"`
+	Anomalies []anomalyModel `tfsdk:"anomalies"`
+}
+
+// anomalyModel maps anomaly data.
+type anomalyModel struct {
+	Id             types.String  `tfsdk:"id"`
+	Attribution    types.String  `tfsdk:"attribution"`
+	BillingAccount types.String  `tfsdk:"billing_account"`
+	CostOfAnomaly  types.Float64 `tfsdk:"cost_of_anomaly"`
+	Platform       types.String  `tfsdk:"platform"`
+	Scope          types.String  `tfsdk:"scope"`
+	ServiceName    types.String  `tfsdk:"service_name"`
+	SeverityLevel  types.String  `tfsdk:"severity_level"`
+	TimeFrame      types.String  `tfsdk:"time_frame"`
+	StartTime      types.String  `tfsdk:"start_time"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &anomaliesDataSource{}
+	_ datasource.DataSourceWithConfigure = &anomaliesDataSource{}
+)
+
+// NewAnomaliesDataSource is a helper function to simplify the provider implementation.
+func NewAnomaliesDataSource() datasource.DataSource {
+	return &anomaliesDataSource{}
+}
+
+// anomaliesDataSource is the data source implementation.
+type anomaliesDataSource struct {
+	client *ClientTest
+}
+
+// Metadata returns the data source type name.
+func (d *anomaliesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_anomalies"
+}
+
+// Schema defines the schema for the data source.
+func (d *anomaliesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the cost anomalies detected in a time window.",
+		Attributes: map[string]schema.Attribute{
+			"start_time": schema.StringAttribute{
+				Description: "Only return anomalies detected after this time (RFC3339)",
+				Optional:    true,
+			},
+			"end_time": schema.StringAttribute{
+				Description: "Only return anomalies detected before this time (RFC3339)",
+				Optional:    true,
+			},
+			"anomalies": schema.ListNestedAttribute{
+				Description: "List of the anomalies detected in the time window",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Identifier of the anomaly",
+							Computed:    true,
+						},
+						"attribution": schema.StringAttribute{
+							Description: "Attribution of the anomaly",
+							Computed:    true,
+						},
+						"billing_account": schema.StringAttribute{
+							Description: "Billing account of the anomaly",
+							Computed:    true,
+						},
+						"cost_of_anomaly": schema.Float64Attribute{
+							Description: "Cost of the anomaly",
+							Computed:    true,
+						},
+						"platform": schema.StringAttribute{
+							Description: "Cloud platform of the anomaly",
+							Computed:    true,
+						},
+						"scope": schema.StringAttribute{
+							Description: "Scope of the anomaly, such as a project or an account",
+							Computed:    true,
+						},
+						"service_name": schema.StringAttribute{
+							Description: "Service of the anomaly",
+							Computed:    true,
+						},
+						"severity_level": schema.StringAttribute{
+							Description: "Severity level of the anomaly",
+							Computed:    true,
+						},
+						"time_frame": schema.StringAttribute{
+							Description: "Time frame of the anomaly (DAILY or HOURLY)",
+							Computed:    true,
+						},
+						"start_time": schema.StringAttribute{
+							Description: "Start time of the anomaly (RFC3339)",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *anomaliesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ClientTest)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ClientTest, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *anomaliesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state anomaliesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := ListOptions{}
+	if !state.StartTime.IsNull() {
+		startTime, err := time.Parse(time.RFC3339, state.StartTime.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("start_time"),
+				"Invalid Start Time",
+				"start_time must be a RFC3339 timestamp: "+err.Error(),
+			)
+			return
+		}
+		opts.MinCreationTime = startTime.UnixMilli()
+	}
+	if !state.EndTime.IsNull() {
+		endTime, err := time.Parse(time.RFC3339, state.EndTime.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("end_time"),
+				"Invalid End Time",
+				"end_time must be a RFC3339 timestamp: "+err.Error(),
+			)
+			return
+		}
+		opts.MaxCreationTime = endTime.UnixMilli()
+	}
+
+	anomalies, err := d.client.Anomalies.ListAnomalies(ctx, opts)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Doit Console Anomalies",
+			"Could not list Doit Console Anomalies: "+err.Error(),
+		)
+		return
+	}
+
+	state.Anomalies = []anomalyModel{}
+	for _, anomaly := range anomalies {
+		state.Anomalies = append(state.Anomalies, anomalyModel{
+			Id:             types.StringValue(anomaly.Id),
+			Attribution:    types.StringValue(anomaly.Attribution),
+			BillingAccount: types.StringValue(anomaly.BillingAccount),
+			CostOfAnomaly:  types.Float64Value(anomaly.CostOfAnomaly),
+			Platform:       types.StringValue(anomaly.Platform),
+			Scope:          types.StringValue(anomaly.Scope),
+			ServiceName:    types.StringValue(anomaly.ServiceName),
+			SeverityLevel:  types.StringValue(anomaly.SeverityLevel),
+			TimeFrame:      types.StringValue(anomaly.TimeFrame),
+			StartTime:      timestampValue(anomaly.StartTime),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-doit-console/internal/fakedoit"
+)
+
+func TestAccAnomaliesDataSource(t *testing.T) {
+	server := testAccServer(t)
+	for _, anomaly := range []map[string]any{
+		// 2024-01-01T00:00:00Z
+		{"id": "anomaly-1", "costOfAnomaly": 12.5, "platform": "google-cloud", "timeFrame": "DAILY", "startTime": 1704067200000},
+		// 2024-01-02T03:04:05Z
+		{"id": "anomaly-2", "attribution": "attribution-1", "billingAccount": "billing-1", "costOfAnomaly": 99.9,
+			"platform": "amazon-web-services", "scope": "project-1", "serviceName": "EC2", "severityLevel": "critical",
+			"timeFrame": "HOURLY", "startTime": 1704164645000},
+		// 2024-02-01T00:00:00Z
+		{"id": "anomaly-3", "costOfAnomaly": 1, "timeFrame": "DAILY", "startTime": 1706745600000},
+	} {
+		server.Put(testAccCustomerContext, fakedoit.Anomalies, anomaly)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+data "doit-console_anomalies" "test" {
+  start_time = "yesterday"
+}
+`,
+				ExpectError: regexp.MustCompile(`start_time must be a RFC3339 timestamp`),
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+data "doit-console_anomalies" "all" {}
+
+data "doit-console_anomalies" "window" {
+  start_time = "2024-01-02T00:00:00Z"
+  end_time   = "2024-01-31T00:00:00Z"
+}
+
+data "doit-console_anomalies" "since" {
+  start_time = "2024-01-02T03:04:05Z"
+}
+
+data "doit-console_anomalies" "until" {
+  end_time = "2024-01-02T03:04:05Z"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.doit-console_anomalies.all", "anomalies.#", "3"),
+					resource.TestCheckResourceAttr("data.doit-console_anomalies.window", "anomalies.#", "1"),
+					resource.TestCheckResourceAttr("data.doit-console_anomalies.window", "anomalies.0.id", "anomaly-2"),
+					resource.TestCheckResourceAttr("data.doit-console_anomalies.window", "anomalies.0.attribution", "attribution-1"),
+					resource.TestCheckResourceAttr("data.doit-console_anomalies.window", "anomalies.0.billing_account", "billing-1"),
+					resource.TestCheckResourceAttr("data.doit-console_anomalies.window", "anomalies.0.cost_of_anomaly", "99.9"),
+					resource.TestCheckResourceAttr("data.doit-console_anomalies.window", "anomalies.0.platform", "amazon-web-services"),
+					resource.TestCheckResourceAttr("data.doit-console_anomalies.window", "anomalies.0.scope", "project-1"),
+					resource.TestCheckResourceAttr("data.doit-console_anomalies.window", "anomalies.0.service_name", "EC2"),
+					resource.TestCheckResourceAttr("data.doit-console_anomalies.window", "anomalies.0.severity_level", "critical"),
+					resource.TestCheckResourceAttr("data.doit-console_anomalies.window", "anomalies.0.time_frame", "HOURLY"),
+					resource.TestCheckResourceAttr("data.doit-console_anomalies.window", "anomalies.0.start_time", "2024-01-02T03:04:05Z"),
+					// The bounds are inclusive.
+					resource.TestCheckResourceAttr("data.doit-console_anomalies.since", "anomalies.#", "2"),
+					resource.TestCheckResourceAttr("data.doit-console_anomalies.since", "anomalies.0.id", "anomaly-2"),
+					resource.TestCheckResourceAttr("data.doit-console_anomalies.until", "anomalies.#", "2"),
+					resource.TestCheckResourceAttr("data.doit-console_anomalies.until", "anomalies.1.id", "anomaly-2"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,16 @@
+package provider
+
+import "context"
+
+// AnomaliesClient groups the endpoints of the DoiT anomalies API, which is
+// served outside of the analytics API.
+type AnomaliesClient struct {
+	client *ClientTest
+}
+
+// ListAnomalies - Returns the cost anomalies matching opts, following the pagination
+func (a *AnomaliesClient) ListAnomalies(ctx context.Context, opts ListOptions) ([]Anomaly, error) {
+	return listAll(ctx, a.client, "/anomalies/v1", opts, func(page *AnomalyList) ([]Anomaly, string) {
+		return page.Anomalies, page.PageToken
+	})
+}
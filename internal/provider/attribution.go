@@ -1,104 +1,38 @@
 package provider
 
 import (
-	"encoding/json"
-	"fmt"
-	"log"
+	"context"
 	"net/http"
-	"strings"
 )
 
 // CreateAttribution - Create new attribution
-func (c *ClientTest) CreateAttribution(attribution Attribution) (*Attribution, error) {
-	rb, err := json.Marshal(attribution)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/analytics/v1/attributions/?customerContext=%s", c.HostURL, c.Auth.CustomerContext), strings.NewReader(string(rb)))
-	log.Println("URL----------------")
-	log.Println(req.URL)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		log.Println("ERROR REQUEST----------------")
-		log.Println(err)
-		return nil, err
-	}
-
-	attributionResponse := Attribution{}
-	err = json.Unmarshal(body, &attributionResponse)
-	if err != nil {
-		log.Println("ERROR UNMARSHALL----------------")
-		log.Println(err)
-		return nil, err
-	}
-	log.Println("Attribution response----------------")
-	log.Println(attributionResponse)
-	return &attributionResponse, nil
+func (a *AnalyticsClient) CreateAttribution(ctx context.Context, attribution Attribution) (*Attribution, error) {
+	return do[Attribution](ctx, a.client, http.MethodPost, analyticsPath("attributions"), nil, attribution)
 }
 
-// UpdateAttribution - Updates an attribution
-func (c *ClientTest) UpdateAttribution(attributionID string, attribution Attribution) (*Attribution, error) {
-	rb, err := json.Marshal(attribution)
+// UpdateAttribution - Updates an attribution, clearing its description when empty
+func (a *AnalyticsClient) UpdateAttribution(ctx context.Context, attributionID string, attribution Attribution) (*Attribution, error) {
+	body, err := patchBody(attribution, "description")
 	if err != nil {
 		return nil, err
 	}
-	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/analytics/v1/attributions/%s/?customerContext=%s", c.HostURL, attributionID, c.Auth.CustomerContext), strings.NewReader(string(rb)))
-	if err != nil {
-		return nil, err
-	}
-	log.Println("Update URL----------------")
-	log.Println(req.URL)
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	attributionResponse := Attribution{}
-	err = json.Unmarshal(body, &attributionResponse)
-	if err != nil {
-		return nil, err
-	}
-	log.Println("Attribution response----------------")
-	log.Println(attributionResponse)
-	return &attributionResponse, nil
+	return do[Attribution](ctx, a.client, http.MethodPatch, analyticsPath("attributions", attributionID), nil, body)
 }
 
-func (c *ClientTest) DeleteAttribution(attributionID string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/analytics/v1/attributions/%s/?customerContext=%s", c.HostURL, attributionID, c.Auth.CustomerContext), nil)
-	if err != nil {
-		return err
-	}
-
-	_, err = c.doRequest(req)
-	if err != nil {
-		return err
-	}
-
-	return nil
+// DeleteAttribution - Deletes an attribution
+func (a *AnalyticsClient) DeleteAttribution(ctx context.Context, attributionID string) error {
+	_, err := do[emptyResponse](ctx, a.client, http.MethodDelete, analyticsPath("attributions", attributionID), nil, nil)
+	return err
 }
 
 // GetAttribution - Returns a specifc attribution
-func (c *ClientTest) GetAttribution(orderID string) (*Attribution, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/analytics/v1/attributions/%s/?customerContext=%s", c.HostURL, orderID, c.Auth.CustomerContext), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	attribution := Attribution{}
-	err = json.Unmarshal(body, &attribution)
-	if err != nil {
-		return nil, err
-	}
+func (a *AnalyticsClient) GetAttribution(ctx context.Context, attributionID string) (*Attribution, error) {
+	return do[Attribution](ctx, a.client, http.MethodGet, analyticsPath("attributions", attributionID), nil, nil)
+}
 
-	return &attribution, nil
+// ListAttributions - Returns all the attributions matching opts, following the pagination
+func (a *AnalyticsClient) ListAttributions(ctx context.Context, opts ListOptions) ([]AttributionListItem, error) {
+	return listAll(ctx, a.client, analyticsPath("attributions"), opts, func(page *AttributionList) ([]AttributionListItem, string) {
+		return page.Attributions, page.PageToken
+	})
 }
@@ -0,0 +1,232 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// attributionDataSourceModel maps the data source schema data.
+type attributionDataSourceModel struct {
+	Id          types.String               `tfsdk:"id"`
+	Name        types.String               `tfsdk:"name"`
+	Description types.String               `tfsdk:"description"`
+	Formula     types.String               `tfsdk:"formula"`
+	Components  []attibutionComponentModel `tfsdk:"components"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &attributionDataSource{}
+	_ datasource.DataSourceWithConfigure      = &attributionDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &attributionDataSource{}
+)
+
+// NewAttributionDataSource is a helper function to simplify the provider implementation.
+func NewAttributionDataSource() datasource.DataSource {
+	return &attributionDataSource{}
+}
+
+// attributionDataSource is the data source implementation.
+type attributionDataSource struct {
+	client *ClientTest
+}
+
+// Metadata returns the data source type name.
+func (d *attributionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_attribution"
+}
+
+// Schema defines the schema for the data source.
+func (d *attributionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches an attribution by ID or by name. Exactly one of id or name must be set.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Numeric identifier of the attribution",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the attribution. The lookup fails if several " +
+					"attributions share this name.",
+				Optional: true,
+				Computed: true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the attribution",
+				Computed:    true,
+			},
+			"formula": schema.StringAttribute{
+				Description: "Attribution formula (A is first component, " +
+					"B is second component, C is third component, etc.)",
+				Computed: true,
+			},
+			"components": schema.ListNestedAttribute{
+				Description: "List of Attributions filters",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "Type of the component",
+							Computed:    true,
+						},
+						"key": schema.StringAttribute{
+							Description: "Key of the type to validate",
+							Computed:    true,
+						},
+						"values": schema.ListAttribute{
+							Description: "Value of the key to validate",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"regexp": schema.StringAttribute{
+							Description: "Regular expression matching the values of the key",
+							Computed:    true,
+						},
+						"inverse_selection": schema.BoolAttribute{
+							Description: "Match the resources whose value is not selected by values or regexp",
+							Computed:    true,
+						},
+						"include_null": schema.BoolAttribute{
+							Description: "Also match the resources without a value for the key, e.g. without the label",
+							Computed:    true,
+						},
+						"allow_null": schema.BoolAttribute{
+							Description: "Allow null values for the key",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *attributionDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ClientTest)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ClientTest, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// ValidateConfig checks that exactly one of id or name is set.
+func (d *attributionDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config attributionDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Id.IsUnknown() || config.Name.IsUnknown() {
+		return
+	}
+
+	if config.Id.IsNull() == config.Name.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Invalid Attribution Lookup",
+			"Exactly one of id or name must be set to look up an attribution.",
+		)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *attributionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state attributionDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.Id.ValueString()
+	if state.Id.IsNull() {
+		attributions, err := d.client.Analytics.ListAttributions(ctx, ListOptions{})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Listing Doit Console Attributions",
+				"Could not list Doit Console Attributions: "+err.Error(),
+			)
+			return
+		}
+
+		var ids []string
+		for _, attribution := range attributions {
+			if attribution.Name == state.Name.ValueString() {
+				ids = append(ids, attribution.Id)
+			}
+		}
+		switch len(ids) {
+		case 0:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Attribution Not Found",
+				"No Doit Console Attribution named "+state.Name.ValueString()+" was found.",
+			)
+			return
+		case 1:
+			id = ids[0]
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Ambiguous Attribution Name",
+				fmt.Sprintf("Several Doit Console Attributions are named %s (IDs: %s), use id to select one of them.",
+					state.Name.ValueString(), strings.Join(ids, ", ")),
+			)
+			return
+		}
+	}
+
+	attribution, err := d.client.Analytics.GetAttribution(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Doit Console Attribution",
+			"Could not read Doit Console Attribution ID "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	state.Id = types.StringValue(id)
+	state.Name = types.StringValue(attribution.Name)
+	state.Description = types.StringValue(attribution.Description)
+	state.Formula = types.StringValue(attribution.Formula)
+	state.Components = []attibutionComponentModel{}
+	for _, component := range attribution.Components {
+		values := []types.String{}
+		for _, value := range component.Values {
+			values = append(values, types.StringValue(value))
+		}
+		state.Components = append(state.Components, attibutionComponentModel{
+			TypeComponent:    types.StringValue(component.TypeComponent),
+			Key:              types.StringValue(component.Key),
+			Values:           values,
+			Regexp:           optionalStringValue(types.StringNull(), component.Regexp),
+			InverseSelection: types.BoolValue(component.InverseSelection),
+			IncludeNull:      types.BoolValue(component.IncludeNull),
+			AllowNull:        types.BoolValue(component.AllowNull),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
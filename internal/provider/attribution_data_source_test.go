@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-doit-console/internal/fakedoit"
+)
+
+func TestAccAttributionDataSource(t *testing.T) {
+	server := testAccServer(t)
+	id := server.Put(testAccCustomerContext, fakedoit.Attributions, map[string]any{
+		"name":        "unique attribution",
+		"description": "looked up",
+		"formula":     "A AND B",
+		"components": []any{
+			map[string]any{"type": "label", "key": "env", "values": []any{"prod", "staging"}},
+			map[string]any{"type": "fixed", "key": "cloud_provider", "regexp": "^google", "inverse_selection": true},
+		},
+	})
+	duplicate1 := server.Put(testAccCustomerContext, fakedoit.Attributions, map[string]any{"name": "duplicate attribution"})
+	duplicate2 := server.Put(testAccCustomerContext, fakedoit.Attributions, map[string]any{"name": "duplicate attribution"})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+data "doit-console_attribution" "test" {
+  id   = "` + id + `"
+  name = "unique attribution"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Exactly one of id or name must be set`),
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+data "doit-console_attribution" "test" {}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Exactly one of id or name must be set`),
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+data "doit-console_attribution" "test" {
+  name = "missing attribution"
+}
+`,
+				ExpectError: regexp.MustCompile(`No Doit Console Attribution named missing attribution was found`),
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+data "doit-console_attribution" "test" {
+  name = "duplicate attribution"
+}
+`,
+				ExpectError: regexp.MustCompile(`Several Doit Console Attributions are named duplicate attribution\s+\(IDs:\s+` +
+					duplicate1 + `,\s+` + duplicate2 + `\)`),
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+data "doit-console_attribution" "test" {
+  id = "missing"
+}
+`,
+				ExpectError: regexp.MustCompile(`Could not read Doit Console Attribution ID missing`),
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+data "doit-console_attribution" "by_id" {
+  id = "` + id + `"
+}
+
+data "doit-console_attribution" "by_name" {
+  name = "unique attribution"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.doit-console_attribution.by_id", "name", "unique attribution"),
+					resource.TestCheckResourceAttr("data.doit-console_attribution.by_id", "description", "looked up"),
+					resource.TestCheckResourceAttr("data.doit-console_attribution.by_id", "formula", "A AND B"),
+					resource.TestCheckResourceAttr("data.doit-console_attribution.by_id", "components.#", "2"),
+					resource.TestCheckResourceAttr("data.doit-console_attribution.by_id", "components.0.type", "label"),
+					resource.TestCheckResourceAttr("data.doit-console_attribution.by_id", "components.0.key", "env"),
+					resource.TestCheckResourceAttr("data.doit-console_attribution.by_id", "components.0.values.#", "2"),
+					resource.TestCheckResourceAttr("data.doit-console_attribution.by_id", "components.0.values.1", "staging"),
+					resource.TestCheckNoResourceAttr("data.doit-console_attribution.by_id", "components.0.regexp"),
+					resource.TestCheckResourceAttr("data.doit-console_attribution.by_id", "components.1.regexp", "^google"),
+					resource.TestCheckResourceAttr("data.doit-console_attribution.by_id", "components.1.inverse_selection", "true"),
+					resource.TestCheckResourceAttr("data.doit-console_attribution.by_name", "id", id),
+					resource.TestCheckResourceAttr("data.doit-console_attribution.by_name", "description", "looked up"),
+					resource.TestCheckResourceAttr("data.doit-console_attribution.by_name", "components.#", "2"),
+				),
+			},
+		},
+	})
+}
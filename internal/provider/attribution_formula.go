@@ -0,0 +1,319 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Operators of an attribution formula.
+const (
+	formulaAnd = "AND"
+	formulaOr  = "OR"
+	formulaNot = "NOT"
+)
+
+// maxFormulaVariables is the number of variables of an attribution formula,
+// from A to Z.
+const maxFormulaVariables = 26
+
+// formulaNode is a node of a parsed attribution formula: either a variable
+// referring to a component, or an operator applied to its operands.
+type formulaNode struct {
+	// Variable Upper case letter of the component, A for the first one.
+	// Unset for operators.
+	Variable rune
+	// Operator One of formulaAnd, formulaOr or formulaNot. Unset for variables.
+	Operator string
+	Operands []*formulaNode
+}
+
+// variables returns the variables of the formula, in order of appearance.
+func (n *formulaNode) variables() []rune {
+	if n.Operator == "" {
+		return []rune{n.Variable}
+	}
+	var variables []rune
+	for _, operand := range n.Operands {
+		variables = append(variables, operand.variables()...)
+	}
+	return variables
+}
+
+// formulaError is a syntax error in an attribution formula.
+type formulaError struct {
+	// Position 1-based position of the error in the formula.
+	Position int
+	Message  string
+}
+
+func (e *formulaError) Error() string {
+	return fmt.Sprintf("%s at position %d", e.Message, e.Position)
+}
+
+// formulaToken is a lexical token of an attribution formula: "(", ")", an
+// operator or a variable.
+type formulaToken struct {
+	text string
+	// position 1-based position of the token in the formula.
+	position int
+}
+
+// tokenizeFormula splits an attribution formula into tokens. Operators and
+// variables are case insensitive and returned in upper case.
+func tokenizeFormula(formula string) ([]formulaToken, error) {
+	var tokens []formulaToken
+	runes := []rune(formula)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(' || r == ')':
+			tokens = append(tokens, formulaToken{text: string(r), position: i + 1})
+			i++
+		case r < unicode.MaxASCII && unicode.IsLetter(r):
+			start := i
+			for i < len(runes) && runes[i] < unicode.MaxASCII && unicode.IsLetter(runes[i]) {
+				i++
+			}
+			word := strings.ToUpper(string(runes[start:i]))
+			switch word {
+			case formulaAnd, formulaOr, formulaNot:
+			default:
+				if len(word) != 1 {
+					return nil, &formulaError{Position: start + 1, Message: fmt.Sprintf("unknown word %q, expected a variable from A to Z, AND, OR or NOT", string(runes[start:i]))}
+				}
+			}
+			tokens = append(tokens, formulaToken{text: word, position: start + 1})
+		default:
+			return nil, &formulaError{Position: i + 1, Message: fmt.Sprintf("unexpected character %q", r)}
+		}
+	}
+	return tokens, nil
+}
+
+// formulaParser is a recursive descent parser of attribution formulas, with
+// the grammar:
+//
+//	or      = and { "OR" and }
+//	and     = not { "AND" not }
+//	not     = "NOT" not | primary
+//	primary = variable | "(" or ")"
+type formulaParser struct {
+	tokens []formulaToken
+	next   int
+	// end 1-based position just after the end of the formula.
+	end int
+}
+
+// parseAttributionFormula parses an attribution formula, such as
+// "A AND (B OR NOT C)".
+func parseAttributionFormula(formula string) (*formulaNode, error) {
+	tokens, err := tokenizeFormula(formula)
+	if err != nil {
+		return nil, err
+	}
+	p := &formulaParser{tokens: tokens, end: len([]rune(formula)) + 1}
+	if len(tokens) == 0 {
+		return nil, &formulaError{Position: p.end, Message: "empty formula"}
+	}
+
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if token, ok := p.peek(); ok {
+		return nil, &formulaError{Position: token.position, Message: fmt.Sprintf("unexpected %q, expected AND, OR or the end of the formula", token.text)}
+	}
+	return node, nil
+}
+
+// peek returns the next token, if any.
+func (p *formulaParser) peek() (formulaToken, bool) {
+	if p.next == len(p.tokens) {
+		return formulaToken{}, false
+	}
+	return p.tokens[p.next], true
+}
+
+// accept consumes the next token if it is text.
+func (p *formulaParser) accept(text string) bool {
+	if token, ok := p.peek(); ok && token.text == text {
+		p.next++
+		return true
+	}
+	return false
+}
+
+func (p *formulaParser) parseOr() (*formulaNode, error) {
+	return p.parseBinary(formulaOr, p.parseAnd)
+}
+
+func (p *formulaParser) parseAnd() (*formulaNode, error) {
+	return p.parseBinary(formulaAnd, p.parseNot)
+}
+
+// parseBinary parses a sequence of operands joined by operator.
+func (p *formulaParser) parseBinary(operator string, operand func() (*formulaNode, error)) (*formulaNode, error) {
+	first, err := operand()
+	if err != nil {
+		return nil, err
+	}
+	operands := []*formulaNode{first}
+	for p.accept(operator) {
+		next, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, next)
+	}
+	if len(operands) == 1 {
+		return first, nil
+	}
+	return &formulaNode{Operator: operator, Operands: operands}, nil
+}
+
+func (p *formulaParser) parseNot() (*formulaNode, error) {
+	if p.accept(formulaNot) {
+		operand, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &formulaNode{Operator: formulaNot, Operands: []*formulaNode{operand}}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *formulaParser) parsePrimary() (*formulaNode, error) {
+	token, ok := p.peek()
+	if !ok {
+		return nil, &formulaError{Position: p.end, Message: "unexpected end of formula, expected a variable, NOT or \"(\""}
+	}
+	switch token.text {
+	case "(":
+		p.next++
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.accept(")") {
+			position := p.end
+			if next, ok := p.peek(); ok {
+				position = next.position
+			}
+			return nil, &formulaError{Position: position, Message: fmt.Sprintf("missing \")\" closing the \"(\" at position %d", token.position)}
+		}
+		return node, nil
+	case ")", formulaAnd, formulaOr, formulaNot:
+		return nil, &formulaError{Position: token.position, Message: fmt.Sprintf("unexpected %q, expected a variable, NOT or \"(\"", token.text)}
+	}
+	p.next++
+	return &formulaNode{Variable: rune(token.text[0])}, nil
+}
+
+// validateAttributionFormula checks that the formula is valid for an
+// attribution with the given number of components: every variable must refer
+// to a component and every component must be used.
+func validateAttributionFormula(formula string, components int) []error {
+	node, err := parseAttributionFormula(formula)
+	if err != nil {
+		return []error{err}
+	}
+
+	var errs []error
+	if components > maxFormulaVariables {
+		errs = append(errs, fmt.Errorf("the formula can refer to at most %d components, the attribution has %d", maxFormulaVariables, components))
+		components = maxFormulaVariables
+	}
+	used := make([]bool, components)
+	undefined := map[rune]bool{}
+	for _, variable := range node.variables() {
+		index := int(variable - 'A')
+		if index < components {
+			used[index] = true
+			continue
+		}
+		if !undefined[variable] {
+			undefined[variable] = true
+			errs = append(errs, fmt.Errorf("variable %c refers to component %d, but the attribution has %d components", variable, index+1, components))
+		}
+	}
+	for index, ok := range used {
+		if !ok {
+			errs = append(errs, fmt.Errorf("component %d is not used, its variable %c must appear in the formula", index+1, 'A'+index))
+		}
+	}
+	return errs
+}
+
+// defaultAttributionFormula returns the formula requiring every one of the
+// given number of components: "A AND B AND C...".
+func defaultAttributionFormula(components int) string {
+	variables := make([]string, 0, components)
+	for index := 0; index < components && index < maxFormulaVariables; index++ {
+		variables = append(variables, string(rune('A'+index)))
+	}
+	return strings.Join(variables, " "+formulaAnd+" ")
+}
+
+// formulasEqual reports whether two formulas are the same regardless of the
+// case and the whitespace, e.g. "a and b" and "A AND B". Formulas which cannot
+// be tokenized are compared as is.
+func formulasEqual(a, b string) bool {
+	aTokens, aErr := tokenizeFormula(a)
+	bTokens, bErr := tokenizeFormula(b)
+	if aErr != nil || bErr != nil {
+		return a == b
+	}
+	if len(aTokens) != len(bTokens) {
+		return false
+	}
+	for i := range aTokens {
+		if aTokens[i].text != bTokens[i].text {
+			return false
+		}
+	}
+	return true
+}
+
+// formulaValue converts a formula returned by the API into the value stored
+// for the formula attribute. The current value is kept when it is the same
+// formula written differently, to avoid a spurious diff.
+func formulaValue(current types.String, value string) types.String {
+	if !current.IsNull() && !current.IsUnknown() && formulasEqual(current.ValueString(), value) {
+		return current
+	}
+	return types.StringValue(value)
+}
+
+// defaultFormulaModifier plans the default formula of an attribution, which
+// requires every component, when the formula is not configured.
+type defaultFormulaModifier struct{}
+
+func (m defaultFormulaModifier) Description(_ context.Context) string {
+	return "Defaults to the formula requiring every component: \"A AND B AND C...\"."
+}
+
+func (m defaultFormulaModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m defaultFormulaModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var components types.List
+	diags := req.Plan.GetAttribute(ctx, path.Root("components"), &components)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || components.IsNull() || components.IsUnknown() {
+		return
+	}
+	resp.PlanValue = types.StringValue(defaultAttributionFormula(len(components.Elements())))
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseAttributionFormula(t *testing.T) {
+	tests := []struct {
+		formula   string
+		variables string
+		// err is a substring of the expected error, if any.
+		err string
+	}{
+		{formula: "A", variables: "A"},
+		{formula: "A AND B", variables: "AB"},
+		{formula: "a and not (b or c)", variables: "ABC"},
+		{formula: "  NOT NOT A\tOR\nB ", variables: "AB"},
+		{formula: "((A)) AND (B OR C) AND D", variables: "ABCD"},
+		{formula: "", err: "empty formula at position 1"},
+		{formula: "   ", err: "empty formula at position 4"},
+		{formula: "A AND", err: "unexpected end of formula, expected a variable, NOT or \"(\" at position 6"},
+		{formula: "A OR OR B", err: "unexpected \"OR\", expected a variable, NOT or \"(\" at position 6"},
+		{formula: "A B", err: "unexpected \"B\", expected AND, OR or the end of the formula at position 3"},
+		{formula: "(A AND B", err: "missing \")\" closing the \"(\" at position 1 at position 9"},
+		{formula: "(A AND B C", err: "missing \")\" closing the \"(\" at position 1 at position 10"},
+		{formula: "A AND B)", err: "unexpected \")\", expected AND, OR or the end of the formula at position 8"},
+		{formula: "()", err: "unexpected \")\", expected a variable, NOT or \"(\" at position 2"},
+		{formula: "A && B", err: "unexpected character '&' at position 3"},
+		{formula: "A XOR B", err: "unknown word \"XOR\", expected a variable from A to Z, AND, OR or NOT at position 3"},
+		{formula: "A1", err: "unexpected character '1' at position 2"},
+	}
+	for _, tt := range tests {
+		node, err := parseAttributionFormula(tt.formula)
+		if tt.err != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("parseAttributionFormula(%q): expected error %q, got %v", tt.formula, tt.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseAttributionFormula(%q): unexpected error: %s", tt.formula, err)
+			continue
+		}
+		if variables := string(node.variables()); variables != tt.variables {
+			t.Errorf("parseAttributionFormula(%q): expected variables %s, got %s", tt.formula, tt.variables, variables)
+		}
+	}
+}
+
+func TestParseAttributionFormulaPrecedence(t *testing.T) {
+	node, err := parseAttributionFormula("A OR NOT B AND C")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// NOT binds tighter than AND, which binds tighter than OR.
+	if node.Operator != formulaOr || len(node.Operands) != 2 {
+		t.Fatalf("expected an OR of 2 operands, got %+v", node)
+	}
+	and := node.Operands[1]
+	if and.Operator != formulaAnd || len(and.Operands) != 2 || and.Operands[0].Operator != formulaNot {
+		t.Fatalf("expected NOT B AND C, got %+v", and)
+	}
+}
+
+func TestValidateAttributionFormula(t *testing.T) {
+	var all []string
+	for variable := 'A'; variable <= 'Z'; variable++ {
+		all = append(all, string(variable))
+	}
+
+	tests := []struct {
+		formula    string
+		components int
+		errs       []string
+	}{
+		{formula: "A AND B", components: 2},
+		{formula: "A OR (B AND NOT C)", components: 3},
+		{formula: "A AND A", components: 1},
+		{formula: "A OR", components: 1, errs: []string{"unexpected end of formula"}},
+		{formula: "A AND C", components: 2, errs: []string{
+			"variable C refers to component 3, but the attribution has 2 components",
+			"component 2 is not used, its variable B must appear in the formula",
+		}},
+		{formula: "A OR D OR D", components: 1, errs: []string{
+			"variable D refers to component 4, but the attribution has 1 components",
+		}},
+		{formula: strings.Join(all, " OR "), components: 27, errs: []string{"at most 26 components"}},
+	}
+	for _, tt := range tests {
+		errs := validateAttributionFormula(tt.formula, tt.components)
+		if len(errs) != len(tt.errs) {
+			t.Errorf("validateAttributionFormula(%q, %d): expected %d errors, got %v", tt.formula, tt.components, len(tt.errs), errs)
+			continue
+		}
+		for i, err := range errs {
+			if !strings.Contains(err.Error(), tt.errs[i]) {
+				t.Errorf("validateAttributionFormula(%q, %d): expected error %q, got %q", tt.formula, tt.components, tt.errs[i], err)
+			}
+		}
+	}
+}
+
+func TestDefaultAttributionFormula(t *testing.T) {
+	for components, expected := range map[int]string{
+		0: "",
+		1: "A",
+		3: "A AND B AND C",
+	} {
+		if formula := defaultAttributionFormula(components); formula != expected {
+			t.Errorf("defaultAttributionFormula(%d): expected %q, got %q", components, expected, formula)
+		}
+	}
+}
+
+func TestFormulasEqual(t *testing.T) {
+	tests := []struct {
+		a, b  string
+		equal bool
+	}{
+		{"A AND B", "A AND B", true},
+		{"a and b", "A AND B", true},
+		{" A\tAND\n(B OR c) ", "A AND (B OR C)", true},
+		{"A AND B", "B AND A", false},
+		{"A AND B", "A OR B", false},
+		{"A AND B", "A AND B AND C", false},
+		{"A && B", "a && b", false},
+	}
+	for _, tt := range tests {
+		if equal := formulasEqual(tt.a, tt.b); equal != tt.equal {
+			t.Errorf("formulasEqual(%q, %q): expected %t, got %t", tt.a, tt.b, tt.equal, equal)
+		}
+	}
+}
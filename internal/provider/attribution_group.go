@@ -1,113 +1,60 @@
 package provider
 
 import (
-	"encoding/json"
-	"fmt"
-	"log"
+	"context"
 	"net/http"
-	"strings"
 )
 
 // CreateAttributionGroup - Create new attributionGroup
-func (c *ClientTest) CreateAttributionGroup(attributionGroup AttributionGroup) (*AttributionGroup, error) {
-	log.Println("CreateAttributionGroup")
-	log.Println(attributionGroup)
-	rb, err := json.Marshal(attributionGroup)
-	if err != nil {
-		return nil, err
-	}
-	log.Println(strings.NewReader(string(rb)))
-
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/analytics/v1/attributiongroups/?customerContext=%s", c.HostURL, c.Auth.CustomerContext), strings.NewReader(string(rb)))
-	log.Println("URL:")
-	log.Println(req.URL)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		log.Println("ERROR REQUEST----------------")
-		log.Println(err)
-		return nil, err
-	}
-
-	attributionGroupResponse := AttributionGroup{}
-	err = json.Unmarshal(body, &attributionGroupResponse)
-	if err != nil {
-		return nil, err
-	}
-	log.Println("AttributionGroup response:")
-	log.Println(attributionGroupResponse)
-	return &attributionGroupResponse, nil
+func (a *AnalyticsClient) CreateAttributionGroup(ctx context.Context, attributionGroup AttributionGroup) (*AttributionGroup, error) {
+	return do[AttributionGroup](ctx, a.client, http.MethodPost, analyticsPath("attributiongroups"), nil, attributionGroup)
 }
 
-// UpdateAttributionGroup - Updates an attributionGroup
-func (c *ClientTest) UpdateAttributionGroup(attributionGroupID string, attributionGroup AttributionGroup) (*AttributionGroup, error) {
-	rb, err := json.Marshal(attributionGroup)
+// UpdateAttributionGroup - Updates an attributionGroup, clearing its description when empty and keeping its attributions when nil
+func (a *AnalyticsClient) UpdateAttributionGroup(ctx context.Context, attributionGroupID string, attributionGroup AttributionGroup) (*AttributionGroup, error) {
+	body, err := patchBody(attributionGroup, "description")
 	if err != nil {
 		return nil, err
 	}
-	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/analytics/v1/attributiongroups/%s/?customerContext=%s", c.HostURL, attributionGroupID, c.Auth.CustomerContext), strings.NewReader(string(rb)))
-	if err != nil {
-		return nil, err
+	if attributionGroup.Attributions != nil {
+		body["attributions"] = attributionGroup.Attributions
 	}
-	log.Println("Update UR:")
-	log.Println(req.URL)
-	body, err := c.doRequest(req)
-	log.Println("body:")
-	log.Println(string(body))
-	if err != nil {
-		return nil, err
-	}
-
-	return &attributionGroup, nil
+	return do[AttributionGroup](ctx, a.client, http.MethodPatch, analyticsPath("attributiongroups", attributionGroupID), nil, body)
 }
 
-func (c *ClientTest) DeleteAttributionGroup(attributionGroupID string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/analytics/v1/attributiongroups/%s/?customerContext=%s", c.HostURL, attributionGroupID, c.Auth.CustomerContext), nil)
-	if err != nil {
-		return err
-	}
-
-	_, err = c.doRequest(req)
-	if err != nil {
-		return err
-	}
-
-	return nil
+// DeleteAttributionGroup - Deletes an attributionGroup
+func (a *AnalyticsClient) DeleteAttributionGroup(ctx context.Context, attributionGroupID string) error {
+	_, err := do[emptyResponse](ctx, a.client, http.MethodDelete, analyticsPath("attributiongroups", attributionGroupID), nil, nil)
+	return err
 }
 
-// GetAttributionGroup - Returns a specifc attribution
-func (c *ClientTest) GetAttributionGroup(attributionGroupID string) (*AttributionGroup, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/analytics/v1/attributiongroups/%s/?customerContext=%s", c.HostURL, attributionGroupID, c.Auth.CustomerContext), nil)
+// GetAttributionGroup - Returns a specifc attribution group. The API returns
+// the attributions of the group as objects, they are converted to their IDs.
+func (a *AnalyticsClient) GetAttributionGroup(ctx context.Context, attributionGroupID string) (*AttributionGroup, error) {
+	attributionGroupGet, err := do[AttributionGroupGet](ctx, a.client, http.MethodGet, analyticsPath("attributiongroups", attributionGroupID), nil, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-	log.Println("AttributionGroup body----------------")
-	log.Println(string(body))
-	attributionGroup := AttributionGroup{}
-	attributionGroupGet := AttributionGroupGet{}
-	err = json.Unmarshal(body, &attributionGroupGet)
-	if err != nil {
-		return nil, err
+	attributionGroup := AttributionGroup{
+		Id:           attributionGroupGet.Id,
+		Name:         attributionGroupGet.Name,
+		Description:  attributionGroupGet.Description,
+		Owner:        attributionGroupGet.Owner,
+		Type:         attributionGroupGet.Type,
+		CreateTime:   attributionGroupGet.CreateTime,
+		UpdateTime:   attributionGroupGet.UpdateTime,
+		Attributions: []string{},
 	}
-
-	//code that copy attributeGroupGet in attributionGroup
-	attributionGroup.Id = attributionGroupGet.Id
-	attributionGroup.Name = attributionGroupGet.Name
-	attributionGroup.Description = attributionGroupGet.Description
-	//code to intialise attributionGroup.Attribution as empty array
-	attributionGroup.Attributions = []string{}
-	//code that iterate attributionGroupGet.Attribution
 	for _, attribution := range attributionGroupGet.Attributions {
 		attributionGroup.Attributions = append(attributionGroup.Attributions, attribution.Id)
 	}
 	return &attributionGroup, nil
+}
 
+// ListAttributionGroups - Returns all the attribution groups matching opts, following the pagination
+func (a *AnalyticsClient) ListAttributionGroups(ctx context.Context, opts ListOptions) ([]AttributionGroupListItem, error) {
+	return listAll(ctx, a.client, analyticsPath("attributiongroups"), opts, func(page *AttributionGroupList) ([]AttributionGroupListItem, string) {
+		return page.AttributionGroups, page.PageToken
+	})
 }
@@ -0,0 +1,315 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// attributionGroupMembershipResourceModel maps the resource schema data.
+type attributionGroupMembershipResourceModel struct {
+	Id                 types.String   `tfsdk:"id"`
+	AttributionGroupId types.String   `tfsdk:"attribution_group_id"`
+	AttributionId      types.String   `tfsdk:"attribution_id"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &attributionGroupMembershipResource{}
+	_ resource.ResourceWithConfigure   = &attributionGroupMembershipResource{}
+	_ resource.ResourceWithImportState = &attributionGroupMembershipResource{}
+)
+
+// attributionGroupLocks serializes the read-modify-write updates of the
+// attributions of a group within the provider process, as Terraform applies
+// the memberships of a group concurrently.
+var attributionGroupLocks = &keyedMutex{}
+
+// keyedMutex is a set of mutexes identified by a key.
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+// lock locks the mutex of key and returns the function unlocking it.
+func (m *keyedMutex) lock(key string) func() {
+	m.mu.Lock()
+	if m.locks == nil {
+		m.locks = map[string]*sync.Mutex{}
+	}
+	l, ok := m.locks[key]
+	if !ok {
+		l = &sync.Mutex{}
+		m.locks[key] = l
+	}
+	m.mu.Unlock()
+
+	l.Lock()
+	return l.Unlock
+}
+
+// NewAttributionGroupMembershipResource is a helper function to simplify the provider implementation.
+func NewAttributionGroupMembershipResource() resource.Resource {
+	return &attributionGroupMembershipResource{}
+}
+
+// attributionGroupMembershipResource is the resource implementation.
+type attributionGroupMembershipResource struct {
+	client *ClientTest
+}
+
+// Metadata returns the resource type name.
+func (r *attributionGroupMembershipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_attribution_group_membership"
+}
+
+// Schema defines the schema for the resource.
+func (r *attributionGroupMembershipResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Membership of an attribution in an attribution group, to manage the attributions " +
+			"of a group from several configurations. The attributions of a group managed with " +
+			"memberships must not also be set in the attributions of its doit-console_attribution_group resource, " +
+			"which must leave them unset.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the membership, in the format <attribution_group_id>/<attribution_id>",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"attribution_group_id": schema.StringAttribute{
+				Description: "ID of the attribution group",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"attribution_id": schema.StringAttribute{
+				Description: "ID of the attribution added to the group",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Delete: true}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *attributionGroupMembershipResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ClientTest)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ClientTest, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// updateAttributions reads the attributions of the group, changes them with
+// update and writes them back, while holding the lock of the group.
+func (r *attributionGroupMembershipResource) updateAttributions(ctx context.Context, groupID string, update func(attributions []string) []string) error {
+	unlock := attributionGroupLocks.lock(r.client.Auth.CustomerContext + "/" + groupID)
+	defer unlock()
+
+	attributionGroup, err := r.client.Analytics.GetAttributionGroup(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	attributionGroup.Attributions = update(attributionGroup.Attributions)
+	_, err = r.client.Analytics.UpdateAttributionGroup(ctx, groupID, AttributionGroup{
+		Name:         attributionGroup.Name,
+		Description:  attributionGroup.Description,
+		Attributions: attributionGroup.Attributions,
+	})
+	return err
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *attributionGroupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan attributionGroupMembershipResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Add the attribution to the group, unless it is already a member
+	attributionID := plan.AttributionId.ValueString()
+	err := r.updateAttributions(ctx, plan.AttributionGroupId.ValueString(), func(attributions []string) []string {
+		for _, attribution := range attributions {
+			if attribution == attributionID {
+				return attributions
+			}
+		}
+		return append(attributions, attributionID)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating DoiT Attribution Group Membership",
+			"Could not add attribution "+attributionID+" to attribution group "+plan.AttributionGroupId.ValueString()+
+				", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	plan.Id = types.StringValue(plan.AttributionGroupId.ValueString() + "/" + attributionID)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *attributionGroupMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state attributionGroupMembershipResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed attribution group value from DoiT
+	attributionGroup, err := r.client.Analytics.GetAttributionGroup(ctx, state.AttributionGroupId.ValueString())
+	if IsNotFound(err) {
+		// The group was deleted outside of Terraform, remove the
+		// membership from the state so it is planned for creation again.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Doit Console AttributionGroup",
+			"Could not read Doit Console AttributionGroup ID "+state.AttributionGroupId.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	member := false
+	for _, attribution := range attributionGroup.Attributions {
+		if attribution == state.AttributionId.ValueString() {
+			member = true
+			break
+		}
+	}
+	if !member {
+		// The attribution was removed from the group outside of Terraform.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on
+// success. Every attribute requires a replacement, so there is nothing to
+// update in the API.
+func (r *attributionGroupMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan attributionGroupMembershipResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ImportState imports an existing membership by its ID, in the format
+// <attribution_group_id>/<attribution_id>.
+func (r *attributionGroupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	groupID, attributionID, ok := strings.Cut(req.ID, "/")
+	if !ok || groupID == "" || attributionID == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier in the format <attribution_group_id>/<attribution_id>, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("attribution_group_id"), groupID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("attribution_id"), attributionID)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *attributionGroupMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state attributionGroupMembershipResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Remove the attribution from the group
+	attributionID := state.AttributionId.ValueString()
+	err := r.updateAttributions(ctx, state.AttributionGroupId.ValueString(), func(attributions []string) []string {
+		remaining := []string{}
+		for _, attribution := range attributions {
+			if attribution != attributionID {
+				remaining = append(remaining, attribution)
+			}
+		}
+		return remaining
+	})
+	if err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting DoiT Attribution Group Membership",
+			"Could not remove attribution "+attributionID+" from attribution group "+state.AttributionGroupId.ValueString()+
+				", unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"terraform-provider-doit-console/internal/fakedoit"
+)
+
+// testAccAttributionGroupMembershipConfig returns the configuration of the
+// memberships of attributions to the group groupID.
+func testAccAttributionGroupMembershipConfig(groupID string, attributions []string) string {
+	return fmt.Sprintf(`
+locals {
+  attributions = [%s]
+}
+
+resource "doit-console_attribution_group_membership" "test" {
+  count                = length(local.attributions)
+  attribution_group_id = %q
+  attribution_id       = local.attributions[count.index]
+}
+`, `"`+strings.Join(attributions, `", "`)+`"`, groupID)
+}
+
+// testAccCheckGroupAttributions checks the attributions of the group groupID
+// in the fake DoiT API, regardless of their order.
+func testAccCheckGroupAttributions(server *fakedoit.Server, groupID string, expected []string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		group, ok := server.Get(testAccCustomerContext, fakedoit.AttributionGroups, groupID)
+		if !ok {
+			return fmt.Errorf("attribution group %s not found in the API", groupID)
+		}
+		var actual []string
+		ids, _ := group["attributions"].([]any)
+		for _, id := range ids {
+			actual = append(actual, id.(string))
+		}
+		sort.Strings(actual)
+		expected = append([]string(nil), expected...)
+		sort.Strings(expected)
+		if strings.Join(actual, ",") != strings.Join(expected, ",") {
+			return fmt.Errorf("attribution group %s: expected attributions %v, got %v", groupID, expected, actual)
+		}
+		return nil
+	}
+}
+
+func TestAccAttributionGroupMembershipResource(t *testing.T) {
+	server := testAccServer(t)
+	// The group and the attributions are owned by other configurations.
+	var attributions []string
+	for i := 0; i < 8; i++ {
+		attributions = append(attributions, server.Put(testAccCustomerContext, fakedoit.Attributions, map[string]any{
+			"name": fmt.Sprintf("team %d", i),
+		}))
+	}
+	groupID := server.Put(testAccCustomerContext, fakedoit.AttributionGroups, map[string]any{
+		"name":         "Teams",
+		"attributions": []any{attributions[0]},
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckGroupAttributions(server, groupID, attributions[:1]),
+		Steps: []resource.TestStep{
+			// Create and Read testing, the memberships are added concurrently
+			{
+				Config: testAccProviderConfig(server) + testAccAttributionGroupMembershipConfig(groupID, attributions[1:]),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("doit-console_attribution_group_membership.test.0", "id", groupID+"/"+attributions[1]),
+					testAccCheckGroupAttributions(server, groupID, attributions),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "doit-console_attribution_group_membership.test[1]",
+				ImportState:       true,
+				ImportStateId:     groupID + "/" + attributions[2],
+				ImportStateVerify: true,
+			},
+			// Remove memberships, concurrently as well
+			{
+				Config: testAccProviderConfig(server) + testAccAttributionGroupMembershipConfig(groupID, attributions[1:3]),
+				Check:  testAccCheckGroupAttributions(server, groupID, attributions[:3]),
+			},
+			// Recreate after removal outside of Terraform testing
+			{
+				PreConfig: func() {
+					server.Put(testAccCustomerContext, fakedoit.AttributionGroups, map[string]any{
+						"id":           groupID,
+						"name":         "Teams",
+						"attributions": []any{attributions[0], attributions[1]},
+					})
+				},
+				Config: testAccProviderConfig(server) + testAccAttributionGroupMembershipConfig(groupID, attributions[1:3]),
+				Check:  testAccCheckGroupAttributions(server, groupID, attributions[:3]),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("doit-console_attribution_group_membership.test[1]", plancheck.ResourceActionCreate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// testAccAttributionGroupWithMembershipsConfig returns the configuration of
+// a group named name whose attributions are left to the memberships of the
+// attributions.
+func testAccAttributionGroupWithMembershipsConfig(name string, attributions []string) string {
+	return fmt.Sprintf(`
+resource "doit-console_attribution_group" "teams" {
+  name = %q
+}
+
+locals {
+  attributions = [%s]
+}
+
+resource "doit-console_attribution_group_membership" "test" {
+  count                = length(local.attributions)
+  attribution_group_id = doit-console_attribution_group.teams.id
+  attribution_id       = local.attributions[count.index]
+}
+`, name, `"`+strings.Join(attributions, `", "`)+`"`)
+}
+
+func TestAccAttributionGroupMembershipResource_group(t *testing.T) {
+	server := testAccServer(t)
+	var attributions []string
+	for i := 0; i < 3; i++ {
+		attributions = append(attributions, server.Put(testAccCustomerContext, fakedoit.Attributions, map[string]any{
+			"name": fmt.Sprintf("team %d", i),
+		}))
+	}
+	var groupID string
+	checkGroupAttributions := func(expected []string) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			return testAccCheckGroupAttributions(server, groupID, expected)(s)
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "doit-console_attribution_group", fakedoit.AttributionGroups),
+		Steps: []resource.TestStep{
+			// The group declared without attributions gets those of the
+			// memberships
+			{
+				Config: testAccProviderConfig(server) + testAccAttributionGroupWithMembershipsConfig("Teams", attributions[:2]),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccStoreID("doit-console_attribution_group.teams", &groupID),
+					checkGroupAttributions(attributions[:2]),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Updating the group keeps the attributions of the memberships
+			{
+				Config: testAccProviderConfig(server) + testAccAttributionGroupWithMembershipsConfig("All teams", attributions[:2]),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("doit-console_attribution_group.teams", "name", "All teams"),
+					resource.TestCheckResourceAttr("doit-console_attribution_group.teams", "attributions.#", "2"),
+					checkGroupAttributions(attributions[:2]),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("doit-console_attribution_group.teams", plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Changing the memberships does not change the group
+			{
+				Config: testAccProviderConfig(server) + testAccAttributionGroupWithMembershipsConfig("All teams", attributions[1:]),
+				Check:  checkGroupAttributions(attributions[1:]),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("doit-console_attribution_group.teams", plancheck.ResourceActionNoop),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
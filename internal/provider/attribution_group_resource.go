@@ -2,14 +2,18 @@ package provider
 
 import (
 	"context"
-
 	"fmt"
-	"time"
-
-	"log"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -18,14 +22,53 @@ type attributionGroupResourceModel struct {
 	Id           types.String   `tfsdk:"id"`
 	Name         types.String   `tfsdk:"name"`
 	Description  types.String   `tfsdk:"description"`
-	Attributions []types.String `tfsdk:"attributions"`
+	Attributions types.Set      `tfsdk:"attributions"`
 	LastUpdated  types.String   `tfsdk:"last_updated"`
+	CreateTime   types.String   `tfsdk:"create_time"`
+	UpdateTime   types.String   `tfsdk:"update_time"`
+	Owner        types.String   `tfsdk:"owner"`
+	Type         types.String   `tfsdk:"type"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+// setMetadata sets the attributes maintained by the API from attributionGroup.
+func (m *attributionGroupResourceModel) setMetadata(attributionGroup *AttributionGroup) {
+	m.LastUpdated = timestampValue(attributionGroup.UpdateTime)
+	m.CreateTime = timestampValue(attributionGroup.CreateTime)
+	m.UpdateTime = timestampValue(attributionGroup.UpdateTime)
+	m.Owner = types.StringValue(attributionGroup.Owner)
+	m.Type = types.StringValue(attributionGroup.Type)
+}
+
+// attributionsValue converts the attribution IDs of a group into the value
+// of the attributions attribute.
+func attributionsValue(ids []string) types.Set {
+	elements := []attr.Value{}
+	for _, id := range ids {
+		elements = append(elements, types.StringValue(id))
+	}
+	return types.SetValueMust(types.StringType, elements)
+}
+
+// configuredAttributions returns the attribution IDs set in the
+// configuration, or nil when the attributions are left unmanaged, e.g. to
+// doit-console_attribution_group_membership resources.
+func configuredAttributions(ctx context.Context, config tfsdk.Config) ([]string, diag.Diagnostics) {
+	var attributions types.Set
+	diags := config.GetAttribute(ctx, path.Root("attributions"), &attributions)
+	if diags.HasError() || attributions.IsNull() {
+		return nil, diags
+	}
+	ids := []string{}
+	diags.Append(attributions.ElementsAs(ctx, &ids, false)...)
+	return ids, diags
 }
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &attributionGroupResource{}
-	_ resource.ResourceWithConfigure = &attributionGroupResource{}
+	_ resource.Resource                = &attributionGroupResource{}
+	_ resource.ResourceWithConfigure   = &attributionGroupResource{}
+	_ resource.ResourceWithImportState = &attributionGroupResource{}
 )
 
 // NewAttributionGroupResource is a helper function to simplify the provider implementation.
@@ -40,23 +83,48 @@ type attributionGroupResource struct {
 
 // Metadata returns the resource type name.
 func (r *attributionGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	log.Println("attribution group Metadata")
 	resp.TypeName = req.ProviderTypeName + "_attribution_group"
 }
 
 // Schema defines the schema for the resource.
 func (r *attributionGroupResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	log.Print("attributionGroup Schema")
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Numeric identifier of the attribution group",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"last_updated": schema.StringAttribute{
-				Description: "Timestamp of the last Terraform update of" +
-					"the attribution group.",
-				Computed: true,
+				Description: "Timestamp of the last update of the attribution group, in RFC 3339 format",
+				Computed:    true,
+			},
+			"create_time": schema.StringAttribute{
+				Description: "Creation time of the attribution group, in RFC 3339 format",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"update_time": schema.StringAttribute{
+				Description: "Last update time of the attribution group, in RFC 3339 format",
+				Computed:    true,
+			},
+			"owner": schema.StringAttribute{
+				Description: "Email address of the owner of the attribution group",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of the attribution group, either \"preset\" or \"custom\"",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
 				Description: "Name of the attribution group",
@@ -66,18 +134,26 @@ func (r *attributionGroupResource) Schema(ctx context.Context, _ resource.Schema
 				Description: "Description of the attribution group",
 				Optional:    true,
 			},
-			"attributions": schema.ListAttribute{
-				Description: "list of the attributions IDs",
-				Required:    true,
+			"attributions": schema.SetAttribute{
+				Description: "Set of the attributions IDs. The order of the attributions in the group is not meaningful. " +
+					"When not set, the attributions of the group are not managed by this resource, e.g. to add them " +
+					"with doit-console_attribution_group_membership resources.",
+				Optional:    true,
+				Computed:    true,
 				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
 	}
 }
 
 // Configure adds the provider configured client to the resource.
 func (r *attributionGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	log.Print("attributionGroup Configure")
 	if req.ProviderData == nil {
 		return
 	}
@@ -98,7 +174,6 @@ func (r *attributionGroupResource) Configure(ctx context.Context, req resource.C
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *attributionGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	log.Println("attributionGroup Create")
 
 	// Retrieve values from plan
 	var plan attributionGroupResourceModel
@@ -108,31 +183,36 @@ func (r *attributionGroupResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Generate API request body from plan
 	var attributionGroup AttributionGroup
 	attributionGroup.Description = plan.Description.ValueString()
 	attributionGroup.Name = plan.Name.ValueString()
-	var attributions []string
-	for _, attribution := range plan.Attributions {
-		attributions = append(attributions, attribution.ValueString())
+	attributionGroup.Attributions, diags = configuredAttributions(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	attributionGroup.Attributions = attributions
-	log.Println("attributionGroup---------------------------------------------------")
-	log.Println(attributionGroup)
 
 	// Create new attributionGroup
-	attributionGroupResponse, err := r.client.CreateAttributionGroup(attributionGroup)
+	attributionGroupResponse, err := r.client.Analytics.CreateAttributionGroup(ctx, attributionGroup)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Error creating attributionGrouppp",
+			"Error creating attribution group",
 			"Could not create attributionGroup, unexpected error: "+err.Error(),
 		)
 		return
 	}
-	log.Println("attributionGroup id---------------------------------------------------")
-	log.Println(attributionGroupResponse.Id)
 	plan.Id = types.StringValue(attributionGroupResponse.Id)
-	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	plan.Attributions = attributionsValue(attributionGroupResponse.Attributions)
+	plan.setMetadata(attributionGroupResponse)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -145,7 +225,6 @@ func (r *attributionGroupResource) Create(ctx context.Context, req resource.Crea
 
 // Read refreshes the Terraform state with the latest data.
 func (r *attributionGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	log.Print("attributionGroup Read")
 	// Get current state
 	var state attributionGroupResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -153,10 +232,22 @@ func (r *attributionGroupResource) Read(ctx context.Context, req resource.ReadRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	log.Print("state id")
-	log.Print(state.Id.ValueString())
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
 	// Get refreshed attributionGroup value from DoiT
-	attributionGroup, err := r.client.GetAttributionGroup(state.Id.ValueString())
+	attributionGroup, err := r.client.Analytics.GetAttributionGroup(ctx, state.Id.ValueString())
+	if IsNotFound(err) {
+		// The object was deleted outside of Terraform, remove it from
+		// the state so it is planned for creation again.
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Doit Console AttributionGroup",
@@ -165,19 +256,15 @@ func (r *attributionGroupResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 	//state.Id = types.StringValue(attributionGroup.Id)
-	state.Description = types.StringValue(attributionGroup.Description)
+	state.Description = optionalStringValue(state.Description, attributionGroup.Description)
 	state.Name = types.StringValue(attributionGroup.Name)
+	state.setMetadata(attributionGroup)
 
 	// Overwrite components with refreshed state
-	state.Attributions = []types.String{}
-	for _, attribution := range attributionGroup.Attributions {
-		state.Attributions = append(state.Attributions, types.StringValue(attribution))
-	}
+	state.Attributions = attributionsValue(attributionGroup.Attributions)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
-	log.Print("state read")
-	log.Print(state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -185,7 +272,6 @@ func (r *attributionGroupResource) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *attributionGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	log.Println("attributionGroup Update")
 	// Retrieve values from plan
 	var plan attributionGroupResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -201,23 +287,27 @@ func (r *attributionGroupResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
-	// Generate API request body from plan
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Generate API request body from plan
 	var attributionGroup AttributionGroup
 	attributionGroup.Id = state.Id.ValueString()
 	attributionGroup.Description = plan.Description.ValueString()
 	attributionGroup.Name = plan.Name.ValueString()
-	var attributions []string
-
-	for _, attribution := range plan.Attributions {
-		attributions = append(attributions, attribution.ValueString())
+	attributionGroup.Attributions, diags = configuredAttributions(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	attributionGroup.Attributions = attributions
-	log.Println("attributionGroup")
-	log.Println(attributionGroup)
 
 	// Update existing attributionGroup
-	_, err := r.client.UpdateAttributionGroup(state.Id.ValueString(), attributionGroup)
+	_, err := r.client.Analytics.UpdateAttributionGroup(ctx, state.Id.ValueString(), attributionGroup)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating DoiT AttributionGroup",
@@ -228,7 +318,7 @@ func (r *attributionGroupResource) Update(ctx context.Context, req resource.Upda
 
 	// Fetch updated items from GetAttributionGroup as UpdateAttributionGroup items are not
 	// populated.
-	attributionGroupResponse, err := r.client.GetAttributionGroup(state.Id.ValueString())
+	attributionGroupResponse, err := r.client.Analytics.GetAttributionGroup(ctx, state.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Doit Console AttributionGroup",
@@ -239,13 +329,10 @@ func (r *attributionGroupResource) Update(ctx context.Context, req resource.Upda
 
 	// Update resource state with updated items and timestamp
 	plan.Id = types.StringValue(attributionGroupResponse.Id)
-	plan.Description = types.StringValue(attributionGroupResponse.Description)
+	plan.Description = optionalStringValue(plan.Description, attributionGroupResponse.Description)
 	plan.Name = types.StringValue(attributionGroupResponse.Name)
-	plan.Attributions = []types.String{}
-	for _, attribution := range attributionGroupResponse.Attributions {
-		plan.Attributions = append(plan.Attributions, types.StringValue(attribution))
-	}
-	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	plan.Attributions = attributionsValue(attributionGroupResponse.Attributions)
+	plan.setMetadata(attributionGroupResponse)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -254,10 +341,14 @@ func (r *attributionGroupResource) Update(ctx context.Context, req resource.Upda
 	}
 }
 
+// ImportState imports an existing attribution group by its ID.
+func (r *attributionGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
 // Delete deletes the resource and removes the Terraform state on success.
 
 func (r *attributionGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	log.Println("attributionGroup Delete")
 	// Retrieve values from state
 	var state attributionGroupResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -266,9 +357,17 @@ func (r *attributionGroupResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete existing attributionGroup
-	err := r.client.DeleteAttributionGroup(state.Id.ValueString())
-	if err != nil {
+	err := r.client.Analytics.DeleteAttributionGroup(ctx, state.Id.ValueString())
+	if err != nil && !IsNotFound(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting DoiT AttributionGroup",
 			"Could not delete attributionGroup, unexpected error: "+err.Error(),
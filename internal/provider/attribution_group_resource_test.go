@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	"terraform-provider-doit-console/internal/fakedoit"
+)
+
+// testAccAttributionGroupAttributions are the attributions referenced by the
+// attribution groups of the tests.
+const testAccAttributionGroupAttributions = `
+resource "doit-console_attribution" "first" {
+  name       = "first attribution"
+  formula    = "A"
+  components = [{ type = "fixed", key = "cloud_provider", values = ["google-cloud"] }]
+}
+
+resource "doit-console_attribution" "second" {
+  name       = "second attribution"
+  formula    = "A"
+  components = [{ type = "fixed", key = "cloud_provider", values = ["amazon-web-services"] }]
+}
+`
+
+func TestAccAttributionGroupResource(t *testing.T) {
+	server := testAccServer(t)
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "doit-console_attribution_group", fakedoit.AttributionGroups),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(server) + testAccAttributionGroupAttributions + `
+resource "doit-console_attribution_group" "test" {
+  name         = "test attribution group"
+  description  = "test description"
+  attributions = [doit-console_attribution.first.id]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("doit-console_attribution_group.test", "id"),
+					resource.TestCheckResourceAttrSet("doit-console_attribution_group.test", "create_time"),
+					resource.TestCheckResourceAttrPair("doit-console_attribution_group.test", "last_updated", "doit-console_attribution_group.test", "update_time"),
+					resource.TestCheckResourceAttr("doit-console_attribution_group.test", "owner", "owner@example.com"),
+					resource.TestCheckResourceAttr("doit-console_attribution_group.test", "type", "custom"),
+					resource.TestCheckResourceAttr("doit-console_attribution_group.test", "name", "test attribution group"),
+					resource.TestCheckResourceAttr("doit-console_attribution_group.test", "description", "test description"),
+					resource.TestCheckResourceAttr("doit-console_attribution_group.test", "attributions.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"doit-console_attribution_group.test", "attributions.*",
+						"doit-console_attribution.first", "id",
+					),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "doit-console_attribution_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(server) + testAccAttributionGroupAttributions + `
+resource "doit-console_attribution_group" "test" {
+  name         = "test attribution group updated"
+  description  = "test description updated"
+  attributions = [doit-console_attribution.first.id, doit-console_attribution.second.id]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccStoreID("doit-console_attribution_group.test", &id),
+					resource.TestCheckResourceAttr("doit-console_attribution_group.test", "name", "test attribution group updated"),
+					resource.TestCheckResourceAttr("doit-console_attribution_group.test", "description", "test description updated"),
+					resource.TestCheckResourceAttr("doit-console_attribution_group.test", "attributions.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"doit-console_attribution_group.test", "attributions.*",
+						"doit-console_attribution.second", "id",
+					),
+					testAccCheckObject(server, "doit-console_attribution_group.test", fakedoit.AttributionGroups,
+						"name", "test attribution group updated"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("doit-console_attribution_group.test", plancheck.ResourceActionUpdate),
+						testAccExpectKnownValue("doit-console_attribution_group.test", "create_time"),
+						testAccExpectKnownValue("doit-console_attribution_group.test", "owner"),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Recreate after deletion outside of Terraform testing
+			{
+				PreConfig: testAccDeleteOutOfBand(server, fakedoit.AttributionGroups, &id),
+				Config: testAccProviderConfig(server) + testAccAttributionGroupAttributions + `
+resource "doit-console_attribution_group" "test" {
+  name         = "test attribution group updated"
+  description  = "test description updated"
+  attributions = [doit-console_attribution.first.id, doit-console_attribution.second.id]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIDChanged("doit-console_attribution_group.test", &id),
+					testAccStoreID("doit-console_attribution_group.test", &id),
+					resource.TestCheckResourceAttr("doit-console_attribution_group.test", "attributions.#", "2"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("doit-console_attribution_group.test", plancheck.ResourceActionCreate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Reordering the attributions is not a change
+			{
+				PreConfig: func() {
+					group, _ := server.Get(testAccCustomerContext, fakedoit.AttributionGroups, id)
+					attributions := group["attributions"].([]any)
+					group["attributions"] = []any{attributions[1], attributions[0]}
+					server.Put(testAccCustomerContext, fakedoit.AttributionGroups, group)
+				},
+				Config: testAccProviderConfig(server) + testAccAttributionGroupAttributions + `
+resource "doit-console_attribution_group" "test" {
+  name         = "test attribution group updated"
+  description  = "test description updated"
+  attributions = [doit-console_attribution.second.id, doit-console_attribution.first.id]
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Removing the description clears it
+			{
+				Config: testAccProviderConfig(server) + testAccAttributionGroupAttributions + `
+resource "doit-console_attribution_group" "test" {
+  name         = "test attribution group updated"
+  attributions = [doit-console_attribution.second.id, doit-console_attribution.first.id]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("doit-console_attribution_group.test", "description"),
+					testAccCheckObject(server, "doit-console_attribution_group.test", fakedoit.AttributionGroups, "description", nil),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("doit-console_attribution_group.test", plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Updating a group without a description
+			{
+				Config: testAccProviderConfig(server) + testAccAttributionGroupAttributions + `
+resource "doit-console_attribution_group" "test" {
+  name         = "test attribution group renamed"
+  attributions = [doit-console_attribution.second.id, doit-console_attribution.first.id]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("doit-console_attribution_group.test", "name", "test attribution group renamed"),
+					resource.TestCheckNoResourceAttr("doit-console_attribution_group.test", "description"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("doit-console_attribution_group.test", plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -2,13 +2,16 @@ package provider
 
 import (
 	"context"
-
 	"fmt"
-	"log"
-	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -20,19 +23,39 @@ type attributionResourceModel struct {
 	Formula     types.String               `tfsdk:"formula"`
 	Components  []attibutionComponentModel `tfsdk:"components"`
 	LastUpdated types.String               `tfsdk:"last_updated"`
+	CreateTime  types.String               `tfsdk:"create_time"`
+	UpdateTime  types.String               `tfsdk:"update_time"`
+	Owner       types.String               `tfsdk:"owner"`
+	Type        types.String               `tfsdk:"type"`
+	Timeouts    timeouts.Value             `tfsdk:"timeouts"`
 }
 
 // orderComponentModel maps order item data.
 type attibutionComponentModel struct {
-	TypeComponent types.String   `tfsdk:"type"`
-	Key           types.String   `tfsdk:"key"`
-	Values        []types.String `tfsdk:"values"`
+	TypeComponent    types.String   `tfsdk:"type"`
+	Key              types.String   `tfsdk:"key"`
+	Values           []types.String `tfsdk:"values"`
+	Regexp           types.String   `tfsdk:"regexp"`
+	InverseSelection types.Bool     `tfsdk:"inverse_selection"`
+	IncludeNull      types.Bool     `tfsdk:"include_null"`
+	AllowNull        types.Bool     `tfsdk:"allow_null"`
+}
+
+// setMetadata sets the attributes maintained by the API from attribution.
+func (m *attributionResourceModel) setMetadata(attribution *Attribution) {
+	m.LastUpdated = timestampValue(attribution.UpdateTime)
+	m.CreateTime = timestampValue(attribution.CreateTime)
+	m.UpdateTime = timestampValue(attribution.UpdateTime)
+	m.Owner = types.StringValue(attribution.Owner)
+	m.Type = types.StringValue(attribution.Type)
 }
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &attributionResource{}
-	_ resource.ResourceWithConfigure = &attributionResource{}
+	_ resource.Resource                   = &attributionResource{}
+	_ resource.ResourceWithConfigure      = &attributionResource{}
+	_ resource.ResourceWithImportState    = &attributionResource{}
+	_ resource.ResourceWithValidateConfig = &attributionResource{}
 )
 
 // NewattributionResource is a helper function to simplify the provider implementation.
@@ -47,23 +70,48 @@ type attributionResource struct {
 
 // Metadata returns the resource type name.
 func (r *attributionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	log.Print(" attribution Metadata")
 	resp.TypeName = req.ProviderTypeName + "_attribution"
 }
 
 // Schema defines the schema for the resource.
-func (r *attributionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	log.Print(" attribution Schema")
+func (r *attributionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Numeric identifier of the attribution",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"last_updated": schema.StringAttribute{
-				Description: "Timestamp of the last Terraform update of" +
-					"the attribution group.",
-				Computed: true,
+				Description: "Timestamp of the last update of the attribution, in RFC 3339 format",
+				Computed:    true,
+			},
+			"create_time": schema.StringAttribute{
+				Description: "Creation time of the attribution, in RFC 3339 format",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"update_time": schema.StringAttribute{
+				Description: "Last update time of the attribution, in RFC 3339 format",
+				Computed:    true,
+			},
+			"owner": schema.StringAttribute{
+				Description: "Email address of the owner of the attribution",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of the attribution, either \"preset\" or \"custom\"",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
 				Description: "Name of the attribution",
@@ -75,8 +123,14 @@ func (r *attributionResource) Schema(_ context.Context, _ resource.SchemaRequest
 			},
 			"formula": schema.StringAttribute{
 				Description: "Attribution formula (A is first component, " +
-					"B is second component, C is third component, etc.)",
+					"B is second component, C is third component, etc.), " +
+					"combining every component with AND, OR, NOT and parentheses. " +
+					"Defaults to the formula requiring every component: \"A AND B AND C...\"",
 				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					defaultFormulaModifier{},
+				},
 			},
 			"components": schema.ListNestedAttribute{
 				Description: "List of Attributions filters",
@@ -84,29 +138,54 @@ func (r *attributionResource) Schema(_ context.Context, _ resource.SchemaRequest
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"type": schema.StringAttribute{
-							Description: "Type of the component (Standard, " +
-								"Labels, Google Kubernetes Engin, Tags etc. )",
+							Description: "Type of the dimension of the component, " +
+								"see the doit-console_dimensions data source. " + oneOfDescription(dimensionTypes),
 							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(dimensionTypes...),
+							},
 						},
 						"key": schema.StringAttribute{
 							Description: "Key of the type to validate",
 							Required:    true,
 						},
 						"values": schema.ListAttribute{
-							Description: "Value of the key to validate",
-							Required:    true,
+							Description: "Value of the key to validate. Exactly one of values or regexp must be set.",
+							Optional:    true,
 							ElementType: types.StringType,
 						},
+						"regexp": schema.StringAttribute{
+							Description: "Regular expression matching the values of the key, e.g. \"^team-\". " +
+								"Exactly one of values or regexp must be set.",
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("values")),
+							},
+						},
+						"inverse_selection": schema.BoolAttribute{
+							Description: "Match the resources whose value is not selected by values or regexp",
+							Optional:    true,
+						},
+						"include_null": schema.BoolAttribute{
+							Description: "Also match the resources without a value for the key, e.g. without the label",
+							Optional:    true,
+						},
+						"allow_null": schema.BoolAttribute{
+							Description: "Allow null values for the key",
+							Optional:    true,
+						},
 					},
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
 	}
 }
 
 // Configure adds the provider configured client to the resource.
 func (r *attributionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	log.Print(" attribution Configure")
 	if req.ProviderData == nil {
 		return
 	}
@@ -125,12 +204,43 @@ func (r *attributionResource) Configure(_ context.Context, req resource.Configur
 	r.client = client
 }
 
+// ValidateConfig checks that the formula is valid and refers to every
+// component.
+func (r *attributionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var formula types.String
+	diags := req.Config.GetAttribute(ctx, path.Root("formula"), &formula)
+	resp.Diagnostics.Append(diags...)
+	var components types.List
+	diags = req.Config.GetAttribute(ctx, path.Root("components"), &components)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if formula.IsUnknown() || formula.IsNull() {
+		return
+	}
+
+	var errs []error
+	if components.IsUnknown() {
+		// The number of components is not known yet, only check the syntax.
+		if _, err := parseAttributionFormula(formula.ValueString()); err != nil {
+			errs = append(errs, err)
+		}
+	} else {
+		errs = validateAttributionFormula(formula.ValueString(), len(components.Elements()))
+	}
+	for _, err := range errs {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("formula"),
+			"Invalid Attribution Formula",
+			fmt.Sprintf("The formula %q is invalid: %s.", formula.ValueString(), err),
+		)
+	}
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *attributionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	log.Println(" attribution Create")
-	log.Println(r.client.Auth.DoiTAPITOken)
-	log.Println("---------------------------------------------------")
-	log.Println(r.client.Auth.CustomerContext)
 
 	// Retrieve values from plan
 	var plan attributionResourceModel
@@ -140,29 +250,23 @@ func (r *attributionResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Generate API request body from plan
 	var attribution Attribution
 	attribution.Description = plan.Description.ValueString()
 	attribution.Name = plan.Name.ValueString()
 	attribution.Formula = plan.Formula.ValueString()
-	var components []Component
-
-	for _, component := range plan.Components {
-		var values []string
-		for _, value := range component.Values {
-			values = append(values, value.ValueString())
-		}
-		components = append(components, Component{
-			TypeComponent: component.TypeComponent.ValueString(),
-			Key:           component.Key.ValueString(),
-			Values:        values})
-	}
-	attribution.Components = components
-	log.Println("attribution---------------------------------------------------")
-	log.Println(attribution)
+	attribution.Components = toComponents(plan.Components)
 
 	// Create new attribution
-	attributionResponse, err := r.client.CreateAttribution(attribution)
+	attributionResponse, err := r.client.Analytics.CreateAttribution(ctx, attribution)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating attribution",
@@ -170,10 +274,9 @@ func (r *attributionResource) Create(ctx context.Context, req resource.CreateReq
 		)
 		return
 	}
-	log.Println("attribution id---------------------------------------------------")
-	log.Println(attributionResponse.Id)
 	plan.Id = types.StringValue(attributionResponse.Id)
-	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	plan.Formula = formulaValue(plan.Formula, attributionResponse.Formula)
+	plan.setMetadata(attributionResponse)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -186,7 +289,6 @@ func (r *attributionResource) Create(ctx context.Context, req resource.CreateReq
 
 // Read refreshes the Terraform state with the latest data.
 func (r *attributionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	log.Print(" attribution Read")
 	// Get current state
 	var state attributionResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -194,10 +296,22 @@ func (r *attributionResource) Read(ctx context.Context, req resource.ReadRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	log.Print("state id:::::::::::::::::::::::::")
-	log.Print(state.Id.ValueString())
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
 	// Get refreshed attribution value from DoiT
-	attribution, err := r.client.GetAttribution(state.Id.ValueString())
+	attribution, err := r.client.Analytics.GetAttribution(ctx, state.Id.ValueString())
+	if IsNotFound(err) {
+		// The object was deleted outside of Terraform, remove it from
+		// the state so it is planned for creation again.
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Doit Console Attribution",
@@ -206,28 +320,16 @@ func (r *attributionResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 	state.Id = types.StringValue(attribution.Id)
-	state.Description = types.StringValue(attribution.Description)
-	state.Formula = types.StringValue(attribution.Formula)
+	state.Description = optionalStringValue(state.Description, attribution.Description)
+	state.Formula = formulaValue(state.Formula, attribution.Formula)
 	state.Name = types.StringValue(attribution.Name)
+	state.setMetadata(attribution)
 
 	// Overwrite components with refreshed state
-	state.Components = []attibutionComponentModel{}
-	for _, component := range attribution.Components {
-		values := []types.String{}
-		for _, value := range component.Values {
-			values = append(values, types.StringValue(value))
-		}
-		state.Components = append(state.Components, attibutionComponentModel{
-			TypeComponent: types.StringValue(component.TypeComponent),
-			Key:           types.StringValue(component.Key),
-			Values:        values,
-		})
-	}
+	state.Components = componentModels(state.Components, attribution.Components)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
-	log.Print("state read")
-	log.Print(state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -235,7 +337,6 @@ func (r *attributionResource) Read(ctx context.Context, req resource.ReadRequest
 }
 
 func (r *attributionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	log.Print("attribution Update")
 	// Retrieve values from plan
 	var plan attributionResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -251,31 +352,24 @@ func (r *attributionResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	// Generate API request body from plan
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Generate API request body from plan
 	var attribution Attribution
 	attribution.Id = state.Id.ValueString()
 	attribution.Description = plan.Description.ValueString()
 	attribution.Name = plan.Name.ValueString()
 	attribution.Formula = plan.Formula.ValueString()
-	var components []Component
-
-	for _, component := range plan.Components {
-		var values []string
-		for _, value := range component.Values {
-			values = append(values, value.ValueString())
-		}
-		components = append(components, Component{
-			TypeComponent: component.TypeComponent.ValueString(),
-			Key:           component.Key.ValueString(),
-			Values:        values})
-	}
-	attribution.Components = components
-	log.Println("attribution---------------------------------------------------")
-	log.Println(attribution)
+	attribution.Components = toComponents(plan.Components)
 
 	// Update existing attribution
-	_, err := r.client.UpdateAttribution(state.Id.ValueString(), attribution)
+	_, err := r.client.Analytics.UpdateAttribution(ctx, state.Id.ValueString(), attribution)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating DoiT Attribution",
@@ -286,7 +380,7 @@ func (r *attributionResource) Update(ctx context.Context, req resource.UpdateReq
 
 	// Fetch updated items from GetAttribution as UpdateAttribution items are not
 	// populated.
-	attributionResponse, err := r.client.GetAttribution(state.Id.ValueString())
+	attributionResponse, err := r.client.Analytics.GetAttribution(ctx, state.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Doit Console Attribution",
@@ -297,22 +391,11 @@ func (r *attributionResource) Update(ctx context.Context, req resource.UpdateReq
 
 	// Update resource state with updated items and timestamp
 	plan.Id = types.StringValue(attributionResponse.Id)
-	plan.Description = types.StringValue(attributionResponse.Description)
-	plan.Formula = types.StringValue(attributionResponse.Formula)
+	plan.Description = optionalStringValue(plan.Description, attributionResponse.Description)
+	plan.Formula = formulaValue(plan.Formula, attributionResponse.Formula)
 	plan.Name = types.StringValue(attributionResponse.Name)
-	plan.Components = []attibutionComponentModel{}
-	for _, component := range attributionResponse.Components {
-		values := []types.String{}
-		for _, value := range component.Values {
-			values = append(values, types.StringValue(value))
-		}
-		plan.Components = append(plan.Components, attibutionComponentModel{
-			TypeComponent: types.StringValue(component.TypeComponent),
-			Key:           types.StringValue(component.Key),
-			Values:        values,
-		})
-	}
-	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	plan.Components = componentModels(plan.Components, attributionResponse.Components)
+	plan.setMetadata(attributionResponse)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -321,10 +404,14 @@ func (r *attributionResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 }
 
+// ImportState imports an existing attribution by its ID.
+func (r *attributionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
 // Delete deletes the resource and removes the Terraform state on success.
 
 func (r *attributionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	log.Print(" attribution Delete")
 	// Retrieve values from state
 	var state attributionResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -333,9 +420,17 @@ func (r *attributionResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete existing attribution
-	err := r.client.DeleteAttribution(state.Id.ValueString())
-	if err != nil {
+	err := r.client.Analytics.DeleteAttribution(ctx, state.Id.ValueString())
+	if err != nil && !IsNotFound(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting DoiT Attribution",
 			"Could not delete attribution, unexpected error: "+err.Error(),
@@ -343,3 +438,47 @@ func (r *attributionResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 }
+
+// toComponents generates the API components from the model.
+func toComponents(models []attibutionComponentModel) []Component {
+	var components []Component
+	for _, model := range models {
+		var values []string
+		for _, value := range model.Values {
+			values = append(values, value.ValueString())
+		}
+		components = append(components, Component{
+			TypeComponent:    model.TypeComponent.ValueString(),
+			Key:              model.Key.ValueString(),
+			Values:           values,
+			Regexp:           model.Regexp.ValueString(),
+			InverseSelection: model.InverseSelection.ValueBool(),
+			IncludeNull:      model.IncludeNull.ValueBool(),
+			AllowNull:        model.AllowNull.ValueBool(),
+		})
+	}
+	return components
+}
+
+// componentModels converts the components returned by the API into the
+// model, keeping the optional attributes unset in current null.
+func componentModels(current []attibutionComponentModel, components []Component) []attibutionComponentModel {
+	models := []attibutionComponentModel{}
+	for i, component := range components {
+		model, _ := listElement(current, i)
+		values := emptyList(model.Values)
+		for _, value := range component.Values {
+			values = append(values, types.StringValue(value))
+		}
+		models = append(models, attibutionComponentModel{
+			TypeComponent:    types.StringValue(component.TypeComponent),
+			Key:              types.StringValue(component.Key),
+			Values:           values,
+			Regexp:           optionalStringValue(model.Regexp, component.Regexp),
+			InverseSelection: optionalBoolValue(model.InverseSelection, component.InverseSelection),
+			IncludeNull:      optionalBoolValue(model.IncludeNull, component.IncludeNull),
+			AllowNull:        optionalBoolValue(model.AllowNull, component.AllowNull),
+		})
+	}
+	return models
+}
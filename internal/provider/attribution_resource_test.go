@@ -0,0 +1,442 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"terraform-provider-doit-console/internal/fakedoit"
+)
+
+func TestAccAttributionResource(t *testing.T) {
+	server := testAccServer(t)
+	testAccClock(server, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "doit-console_attribution", fakedoit.Attributions),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_attribution" "test" {
+  name        = "test attribution"
+  description = "test description"
+  formula     = "A"
+  components  = [{ type = "label", key = "iris_location", values = ["us"] }]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("doit-console_attribution.test", "id"),
+					resource.TestCheckResourceAttr("doit-console_attribution.test", "last_updated", "2024-01-02T03:04:05Z"),
+					resource.TestCheckResourceAttr("doit-console_attribution.test", "create_time", "2024-01-02T03:04:05Z"),
+					resource.TestCheckResourceAttr("doit-console_attribution.test", "update_time", "2024-01-02T03:04:05Z"),
+					resource.TestCheckResourceAttr("doit-console_attribution.test", "owner", "owner@example.com"),
+					resource.TestCheckResourceAttr("doit-console_attribution.test", "type", "custom"),
+					resource.TestCheckResourceAttr("doit-console_attribution.test", "name", "test attribution"),
+					resource.TestCheckResourceAttr("doit-console_attribution.test", "description", "test description"),
+					resource.TestCheckResourceAttr("doit-console_attribution.test", "formula", "A"),
+					resource.TestCheckResourceAttr("doit-console_attribution.test", "components.#", "1"),
+					resource.TestCheckResourceAttr("doit-console_attribution.test", "components.0.type", "label"),
+					resource.TestCheckResourceAttr("doit-console_attribution.test", "components.0.key", "iris_location"),
+					resource.TestCheckResourceAttr("doit-console_attribution.test", "components.0.values.#", "1"),
+					resource.TestCheckResourceAttr("doit-console_attribution.test", "components.0.values.0", "us"),
+					testAccCheckObject(server, "doit-console_attribution.test", fakedoit.Attributions, "name", "test attribution"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "doit-console_attribution.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_attribution" "test" {
+  name        = "test attribution updated"
+  description = "test description updated"
+  formula     = "A AND B"
+  components = [
+    { type = "label", key = "iris_location", values = ["us", "eu"] },
+    { type = "fixed", key = "cloud_provider", values = ["google-cloud"] },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccStoreID("doit-console_attribution.test", &id),
+					resource.TestCheckResourceAttr("doit-console_attribution.test", "name", "test attribution updated"),
+					resource.TestCheckResourceAttr("doit-console_attribution.test", "description", "test description updated"),
+					resource.TestCheckResourceAttr("doit-console_attribution.test", "formula", "A AND B"),
+					resource.TestCheckResourceAttr("doit-console_attribution.test", "components.#", "2"),
+					resource.TestCheckResourceAttr("doit-console_attribution.test", "components.0.values.#", "2"),
+					resource.TestCheckResourceAttr("doit-console_attribution.test", "components.0.values.1", "eu"),
+					resource.TestCheckResourceAttr("doit-console_attribution.test", "components.1.type", "fixed"),
+					resource.TestCheckResourceAttr("doit-console_attribution.test", "components.1.key", "cloud_provider"),
+					resource.TestCheckResourceAttr("doit-console_attribution.test", "last_updated", "2024-01-02T03:05:05Z"),
+					resource.TestCheckResourceAttr("doit-console_attribution.test", "create_time", "2024-01-02T03:04:05Z"),
+					resource.TestCheckResourceAttr("doit-console_attribution.test", "update_time", "2024-01-02T03:05:05Z"),
+					testAccCheckObject(server, "doit-console_attribution.test", fakedoit.Attributions, "formula", "A AND B"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("doit-console_attribution.test", plancheck.ResourceActionUpdate),
+						testAccExpectKnownValue("doit-console_attribution.test", "id"),
+						testAccExpectKnownValue("doit-console_attribution.test", "create_time"),
+						testAccExpectKnownValue("doit-console_attribution.test", "owner"),
+						testAccExpectKnownValue("doit-console_attribution.test", "type"),
+						plancheck.ExpectUnknownValue("doit-console_attribution.test", tfjsonpath.New("update_time")),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Recreate after deletion outside of Terraform testing
+			{
+				PreConfig: testAccDeleteOutOfBand(server, fakedoit.Attributions, &id),
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_attribution" "test" {
+  name        = "test attribution updated"
+  description = "test description updated"
+  formula     = "A AND B"
+  components = [
+    { type = "label", key = "iris_location", values = ["us", "eu"] },
+    { type = "fixed", key = "cloud_provider", values = ["google-cloud"] },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIDChanged("doit-console_attribution.test", &id),
+					testAccCheckObject(server, "doit-console_attribution.test", fakedoit.Attributions, "name", "test attribution updated"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("doit-console_attribution.test", plancheck.ResourceActionCreate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Removing the description clears it
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_attribution" "test" {
+  name    = "test attribution updated"
+  formula = "A AND B"
+  components = [
+    { type = "label", key = "iris_location", values = ["us", "eu"] },
+    { type = "fixed", key = "cloud_provider", values = ["google-cloud"] },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("doit-console_attribution.test", "description"),
+					testAccCheckObject(server, "doit-console_attribution.test", fakedoit.Attributions, "description", nil),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("doit-console_attribution.test", plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAttributionResource_invalidConfig(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_attribution" "test" {
+  name       = "test attribution"
+  formula    = "A AND (B"
+  components = [
+    { type = "label", key = "iris_location", values = ["us"] },
+    { type = "fixed", key = "cloud_provider", values = ["google-cloud"] },
+  ]
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`missing "\)" closing the "\(" at position 7`),
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_attribution" "test" {
+  name       = "test attribution"
+  formula    = "A OR C"
+  components = [
+    { type = "label", key = "iris_location", values = ["us"] },
+    { type = "fixed", key = "cloud_provider", values = ["google-cloud"] },
+  ]
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`variable C refers to component 3`),
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_attribution" "test" {
+  name       = "test attribution"
+  components = [{ type = "Labels", key = "iris_location", values = ["us"] }]
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Attribute components\[0\].type value must be one of`),
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_attribution" "test" {
+  name       = "test attribution"
+  components = [{ type = "label", key = "team", values = ["a"], regexp = "^team-" }]
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_attribution" "test" {
+  name       = "test attribution"
+  components = [{ type = "label", key = "team" }]
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func TestAccAttributionResource_defaultFormula(t *testing.T) {
+	server := testAccServer(t)
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "doit-console_attribution", fakedoit.Attributions),
+		Steps: []resource.TestStep{
+			// The formula defaults to requiring every component
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_attribution" "test" {
+  name       = "test attribution"
+  components = [
+    { type = "label", key = "iris_location", values = ["us"] },
+    { type = "fixed", key = "cloud_provider", values = ["google-cloud"] },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("doit-console_attribution.test", "formula", "A AND B"),
+					testAccCheckObject(server, "doit-console_attribution.test", fakedoit.Attributions, "formula", "A AND B"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// The default formula follows the components
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_attribution" "test" {
+  name       = "test attribution"
+  components = [
+    { type = "label", key = "iris_location", values = ["us"] },
+    { type = "fixed", key = "cloud_provider", values = ["google-cloud"] },
+    { type = "fixed", key = "service_description", values = ["Compute Engine"] },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccStoreID("doit-console_attribution.test", &id),
+					resource.TestCheckResourceAttr("doit-console_attribution.test", "formula", "A AND B AND C"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("doit-console_attribution.test", plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// A formula written differently by the API is not a change
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_attribution" "test" {
+  name       = "test attribution"
+  formula    = "a and  (b or c)"
+  components = [
+    { type = "label", key = "iris_location", values = ["us"] },
+    { type = "fixed", key = "cloud_provider", values = ["google-cloud"] },
+    { type = "fixed", key = "service_description", values = ["Compute Engine"] },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("doit-console_attribution.test", "formula", "a and  (b or c)"),
+					func(*terraform.State) error {
+						obj, _ := server.Get(testAccCustomerContext, fakedoit.Attributions, id)
+						obj["formula"] = "A AND (B OR C)"
+						server.Put(testAccCustomerContext, fakedoit.Attributions, obj)
+						return nil
+					},
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAttributionResource_componentOptions(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "doit-console_attribution", fakedoit.Attributions),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_attribution" "test" {
+  name = "test attribution"
+  components = [
+    { type = "label", key = "team", regexp = "^team-", include_null = true },
+    { type = "fixed", key = "cloud_provider", values = ["google-cloud"], inverse_selection = true },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("doit-console_attribution.test", "components.0.regexp", "^team-"),
+					resource.TestCheckResourceAttr("doit-console_attribution.test", "components.0.include_null", "true"),
+					resource.TestCheckNoResourceAttr("doit-console_attribution.test", "components.0.values"),
+					resource.TestCheckNoResourceAttr("doit-console_attribution.test", "components.0.inverse_selection"),
+					resource.TestCheckResourceAttr("doit-console_attribution.test", "components.1.inverse_selection", "true"),
+					resource.TestCheckNoResourceAttr("doit-console_attribution.test", "components.1.regexp"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "doit-console_attribution.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_attribution" "test" {
+  name = "test attribution"
+  components = [
+    { type = "label", key = "team", values = ["team-a", "team-b"], allow_null = true },
+    { type = "fixed", key = "cloud_provider", values = ["google-cloud"] },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("doit-console_attribution.test", "components.0.regexp"),
+					resource.TestCheckNoResourceAttr("doit-console_attribution.test", "components.0.include_null"),
+					resource.TestCheckResourceAttr("doit-console_attribution.test", "components.0.allow_null", "true"),
+					resource.TestCheckResourceAttr("doit-console_attribution.test", "components.0.values.#", "2"),
+					resource.TestCheckNoResourceAttr("doit-console_attribution.test", "components.1.inverse_selection"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("doit-console_attribution.test", plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAttributionResource_timeouts(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "doit-console_attribution", fakedoit.Attributions),
+		Steps: []resource.TestStep{
+			// Create timeout testing
+			{
+				PreConfig: func() {
+					server.InjectFault(fakedoit.Fault{
+						Method: "POST",
+						Path:   "/analytics/v1/attributions",
+						Delay:  time.Minute,
+						Times:  1,
+					})
+				},
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_attribution" "test" {
+  name       = "test attribution"
+  components = [{ type = "fixed", key = "cloud_provider", values = ["google-cloud"] }]
+
+  timeouts {
+    create = "1s"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`context deadline exceeded`),
+			},
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_attribution" "test" {
+  name       = "test attribution"
+  components = [{ type = "fixed", key = "cloud_provider", values = ["google-cloud"] }]
+
+  timeouts {
+    create = "1m"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("doit-console_attribution.test", "id"),
+					resource.TestCheckResourceAttr("doit-console_attribution.test", "timeouts.create", "1m"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+)
+
+// CreateBudget - Create new budget
+func (a *AnalyticsClient) CreateBudget(ctx context.Context, budget Budget) (*Budget, error) {
+	return do[Budget](ctx, a.client, http.MethodPost, analyticsPath("budgets"), nil, budget)
+}
+
+// UpdateBudget - Updates a budget, clearing its empty optional fields
+func (a *AnalyticsClient) UpdateBudget(ctx context.Context, budgetID string, budget Budget) (*Budget, error) {
+	body, err := patchBody(budget, "description", "alerts", "amount", "currency", "endPeriod", "growthPerPeriod",
+		"metric", "recipients", "recipientsSlackChannels")
+	if err != nil {
+		return nil, err
+	}
+	return do[Budget](ctx, a.client, http.MethodPatch, analyticsPath("budgets", budgetID), nil, body)
+}
+
+// DeleteBudget - Deletes a budget
+func (a *AnalyticsClient) DeleteBudget(ctx context.Context, budgetID string) error {
+	_, err := do[emptyResponse](ctx, a.client, http.MethodDelete, analyticsPath("budgets", budgetID), nil, nil)
+	return err
+}
+
+// GetBudget - Returns a specifc budget
+func (a *AnalyticsClient) GetBudget(ctx context.Context, budgetID string) (*Budget, error) {
+	return do[Budget](ctx, a.client, http.MethodGet, analyticsPath("budgets", budgetID), nil, nil)
+}
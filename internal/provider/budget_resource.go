@@ -0,0 +1,495 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Values accepted by the DoiT API for the enum fields of a budget.
+var (
+	budgetMetrics       = []string{"cost", "amortized_cost"}
+	budgetTimeIntervals = []string{"day", "week", "month", "quarter", "year"}
+	budgetTypes         = []string{"fixed", "recurring"}
+)
+
+// budgetResourceModel maps the resource schema data.
+type budgetResourceModel struct {
+	Id                      types.String        `tfsdk:"id"`
+	Name                    types.String        `tfsdk:"name"`
+	Description             types.String        `tfsdk:"description"`
+	Alerts                  []budgetAlertModel  `tfsdk:"alerts"`
+	Amount                  types.Float64       `tfsdk:"amount"`
+	Currency                types.String        `tfsdk:"currency"`
+	StartPeriod             types.Int64         `tfsdk:"start_period"`
+	EndPeriod               types.Int64         `tfsdk:"end_period"`
+	GrowthPerPeriod         types.Float64       `tfsdk:"growth_per_period"`
+	Metric                  types.String        `tfsdk:"metric"`
+	Recipients              []types.String      `tfsdk:"recipients"`
+	RecipientsSlackChannels []slackChannelModel `tfsdk:"recipients_slack_channels"`
+	Scope                   []types.String      `tfsdk:"scope"`
+	TimeInterval            types.String        `tfsdk:"time_interval"`
+	Type                    types.String        `tfsdk:"type"`
+	UsePrevSpend            types.Bool          `tfsdk:"use_prev_spend"`
+	LastUpdated             types.String        `tfsdk:"last_updated"`
+	Timeouts                timeouts.Value      `tfsdk:"timeouts"`
+}
+
+// budgetAlertModel maps budget alert data.
+type budgetAlertModel struct {
+	Percentage types.Float64 `tfsdk:"percentage"`
+}
+
+// slackChannelModel maps Slack channel recipient data.
+type slackChannelModel struct {
+	CustomerId types.String `tfsdk:"customer_id"`
+	Id         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Shared     types.Bool   `tfsdk:"shared"`
+	Type       types.String `tfsdk:"type"`
+	Workspace  types.String `tfsdk:"workspace"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &budgetResource{}
+	_ resource.ResourceWithConfigure   = &budgetResource{}
+	_ resource.ResourceWithImportState = &budgetResource{}
+)
+
+// NewBudgetResource is a helper function to simplify the provider implementation.
+func NewBudgetResource() resource.Resource {
+	return &budgetResource{}
+}
+
+// budgetResource is the resource implementation.
+type budgetResource struct {
+	client *ClientTest
+}
+
+// Metadata returns the resource type name.
+func (r *budgetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_budget"
+}
+
+// Schema defines the schema for the resource.
+func (r *budgetResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the budget",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last update of the budget, in RFC 3339 format",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the budget",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the budget",
+				Optional:    true,
+			},
+			"alerts": schema.ListNestedAttribute{
+				Description: "List of up to three alert thresholds",
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(3),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"percentage": schema.Float64Attribute{
+							Description: "Percentage of the budget amount triggering the alert",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"amount": schema.Float64Attribute{
+				Description: "Budget amount. Required unless use_prev_spend is set",
+				Optional:    true,
+			},
+			"currency": schema.StringAttribute{
+				Description: "Currency of the budget amount. " + oneOfDescription(reportCurrencies),
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(reportCurrencies...),
+				},
+			},
+			"start_period": schema.Int64Attribute{
+				Description: "Start of the budget, in milliseconds since the epoch",
+				Required:    true,
+			},
+			"end_period": schema.Int64Attribute{
+				Description: "End of a fixed budget, in milliseconds since the epoch",
+				Optional:    true,
+			},
+			"growth_per_period": schema.Float64Attribute{
+				Description: "Periodical growth percentage of a recurring budget",
+				Optional:    true,
+			},
+			"metric": schema.StringAttribute{
+				Description: "Metric tracked by the budget. " + oneOfDescription(budgetMetrics),
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(budgetMetrics...),
+				},
+			},
+			"recipients": schema.ListAttribute{
+				Description: "List of emails to notify when reaching alert thresholds",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"recipients_slack_channels": schema.ListNestedAttribute{
+				Description: "List of Slack channels to notify when reaching alert thresholds",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"customer_id": schema.StringAttribute{
+							Description: "Slack customer id",
+							Optional:    true,
+						},
+						"id": schema.StringAttribute{
+							Description: "Slack channel id",
+							Required:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Slack channel name",
+							Optional:    true,
+						},
+						"shared": schema.BoolAttribute{
+							Description: "Whether the channel is shared",
+							Optional:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of the Slack channel",
+							Optional:    true,
+						},
+						"workspace": schema.StringAttribute{
+							Description: "Slack workspace id",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"scope": schema.ListAttribute{
+				Description: "List of attribution IDs the budget applies to",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"time_interval": schema.StringAttribute{
+				Description: "Recurring budget interval. " + oneOfDescription(budgetTimeIntervals),
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(budgetTimeIntervals...),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of the budget. " + oneOfDescription(budgetTypes),
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(budgetTypes...),
+				},
+			},
+			"use_prev_spend": schema.BoolAttribute{
+				Description: "Use the last period's spend as the target amount of a recurring budget",
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *budgetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ClientTest)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ClientTest, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// toBudget generates the API request body from the model.
+func (m *budgetResourceModel) toBudget() Budget {
+	budget := Budget{
+		Id:              m.Id.ValueString(),
+		Name:            m.Name.ValueString(),
+		Description:     m.Description.ValueString(),
+		Amount:          m.Amount.ValueFloat64(),
+		Currency:        m.Currency.ValueString(),
+		StartPeriod:     m.StartPeriod.ValueInt64(),
+		EndPeriod:       m.EndPeriod.ValueInt64(),
+		GrowthPerPeriod: m.GrowthPerPeriod.ValueFloat64(),
+		Metric:          m.Metric.ValueString(),
+		Scope:           []string{},
+		TimeInterval:    m.TimeInterval.ValueString(),
+		Type:            m.Type.ValueString(),
+		UsePrevSpend:    m.UsePrevSpend.ValueBool(),
+	}
+	for _, alert := range m.Alerts {
+		budget.Alerts = append(budget.Alerts, BudgetAlert{
+			Percentage: alert.Percentage.ValueFloat64(),
+		})
+	}
+	for _, recipient := range m.Recipients {
+		budget.Recipients = append(budget.Recipients, recipient.ValueString())
+	}
+	for _, channel := range m.RecipientsSlackChannels {
+		budget.RecipientsSlackChannels = append(budget.RecipientsSlackChannels, SlackChannel{
+			CustomerId: channel.CustomerId.ValueString(),
+			Id:         channel.Id.ValueString(),
+			Name:       channel.Name.ValueString(),
+			Shared:     channel.Shared.ValueBool(),
+			Type:       channel.Type.ValueString(),
+			Workspace:  channel.Workspace.ValueString(),
+		})
+	}
+	for _, scope := range m.Scope {
+		budget.Scope = append(budget.Scope, scope.ValueString())
+	}
+	return budget
+}
+
+// fromBudget overwrites the model with the budget returned by the API.
+func (m *budgetResourceModel) fromBudget(budget *Budget) {
+	if budget.Id != "" {
+		m.Id = types.StringValue(budget.Id)
+	}
+	m.Name = types.StringValue(budget.Name)
+	m.Description = optionalStringValue(m.Description, budget.Description)
+	m.Amount = optionalFloat64Value(m.Amount, budget.Amount)
+	m.Currency = optionalStringValue(m.Currency, budget.Currency)
+	m.StartPeriod = types.Int64Value(budget.StartPeriod)
+	m.EndPeriod = optionalInt64Value(m.EndPeriod, budget.EndPeriod)
+	m.GrowthPerPeriod = optionalFloat64Value(m.GrowthPerPeriod, budget.GrowthPerPeriod)
+	m.Metric = optionalStringValue(m.Metric, budget.Metric)
+	m.TimeInterval = types.StringValue(budget.TimeInterval)
+	m.Type = types.StringValue(budget.Type)
+	m.UsePrevSpend = optionalBoolValue(m.UsePrevSpend, budget.UsePrevSpend)
+
+	m.Alerts = emptyList(m.Alerts)
+	for _, alert := range budget.Alerts {
+		m.Alerts = append(m.Alerts, budgetAlertModel{
+			Percentage: types.Float64Value(alert.Percentage),
+		})
+	}
+
+	m.Recipients = emptyList(m.Recipients)
+	for _, recipient := range budget.Recipients {
+		m.Recipients = append(m.Recipients, types.StringValue(recipient))
+	}
+
+	channels := m.RecipientsSlackChannels
+	m.RecipientsSlackChannels = emptyList(channels)
+	for i, channel := range budget.RecipientsSlackChannels {
+		current := slackChannelModel{}
+		if i < len(channels) {
+			current = channels[i]
+		}
+		m.RecipientsSlackChannels = append(m.RecipientsSlackChannels, slackChannelModel{
+			CustomerId: optionalStringValue(current.CustomerId, channel.CustomerId),
+			Id:         types.StringValue(channel.Id),
+			Name:       optionalStringValue(current.Name, channel.Name),
+			Shared:     optionalBoolValue(current.Shared, channel.Shared),
+			Type:       optionalStringValue(current.Type, channel.Type),
+			Workspace:  optionalStringValue(current.Workspace, channel.Workspace),
+		})
+	}
+
+	m.Scope = []types.String{}
+	for _, scope := range budget.Scope {
+		m.Scope = append(m.Scope, types.StringValue(scope))
+	}
+	m.LastUpdated = timestampValue(budget.UpdateTime)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *budgetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan budgetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Create new budget
+	budgetResponse, err := r.client.Analytics.CreateBudget(ctx, plan.toBudget())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating budget",
+			"Could not create budget, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	plan.Id = types.StringValue(budgetResponse.Id)
+	plan.LastUpdated = timestampValue(budgetResponse.UpdateTime)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *budgetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state budgetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed budget value from DoiT
+	budget, err := r.client.Analytics.GetBudget(ctx, state.Id.ValueString())
+	if IsNotFound(err) {
+		// The object was deleted outside of Terraform, remove it from
+		// the state so it is planned for creation again.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Doit Console Budget",
+			"Could not read Doit Console Budget ID "+state.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	state.fromBudget(budget)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *budgetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan budgetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state budgetResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Update existing budget
+	budget := plan.toBudget()
+	budget.Id = state.Id.ValueString()
+	_, err := r.client.Analytics.UpdateBudget(ctx, state.Id.ValueString(), budget)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating DoiT Budget",
+			"Could not update budget, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Fetch updated items from GetBudget
+	budgetResponse, err := r.client.Analytics.GetBudget(ctx, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Doit Console Budget",
+			"Could not read Doit Console budget ID "+state.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update resource state with updated items and timestamp
+	plan.Id = state.Id
+	plan.fromBudget(budgetResponse)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ImportState imports an existing budget by its ID.
+func (r *budgetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *budgetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state budgetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing budget
+	err := r.client.Analytics.DeleteBudget(ctx, state.Id.ValueString())
+	if err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting DoiT Budget",
+			"Could not delete budget, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
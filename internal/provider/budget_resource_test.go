@@ -0,0 +1,238 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	"terraform-provider-doit-console/internal/fakedoit"
+)
+
+func TestAccBudgetResource(t *testing.T) {
+	server := testAccServer(t)
+	testAccClock(server, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "doit-console_budget", fakedoit.Budgets),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_budget" "test" {
+  name          = "test budget"
+  description   = "test description"
+  amount        = 1000
+  currency      = "USD"
+  metric        = "cost"
+  start_period  = 1704067200000
+  time_interval = "month"
+  type          = "recurring"
+  scope         = ["attribution-1"]
+  alerts        = [{ percentage = 50 }, { percentage = 100 }]
+  recipients    = []
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("doit-console_budget.test", "id"),
+					resource.TestCheckResourceAttr("doit-console_budget.test", "last_updated", "2024-01-02T03:04:05Z"),
+					resource.TestCheckResourceAttr("doit-console_budget.test", "name", "test budget"),
+					resource.TestCheckResourceAttr("doit-console_budget.test", "amount", "1000"),
+					resource.TestCheckResourceAttr("doit-console_budget.test", "alerts.#", "2"),
+					resource.TestCheckResourceAttr("doit-console_budget.test", "alerts.1.percentage", "100"),
+					resource.TestCheckResourceAttr("doit-console_budget.test", "recipients.#", "0"),
+					resource.TestCheckResourceAttr("doit-console_budget.test", "scope.#", "1"),
+					testAccCheckObject(server, "doit-console_budget.test", fakedoit.Budgets, "timeInterval", "month"),
+					testAccCheckObject(server, "doit-console_budget.test", fakedoit.Budgets, "startPeriod", float64(1704067200000)),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// ImportState testing. The API omits the empty recipients.
+			{
+				ResourceName:            "doit-console_budget.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"recipients"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_budget" "test" {
+  name          = "test budget updated"
+  description   = "updated description"
+  amount        = 2000
+  currency      = "EUR"
+  metric        = "amortized_cost"
+  start_period  = 1704067200000
+  time_interval = "quarter"
+  type          = "recurring"
+  scope         = ["attribution-1", "attribution-2"]
+  alerts        = [{ percentage = 80 }]
+  recipients    = ["finops@example.com"]
+  recipients_slack_channels = [{ id = "C123", name = "finops" }]
+  growth_per_period = 5
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccStoreID("doit-console_budget.test", &id),
+					resource.TestCheckResourceAttr("doit-console_budget.test", "name", "test budget updated"),
+					resource.TestCheckResourceAttr("doit-console_budget.test", "description", "updated description"),
+					resource.TestCheckResourceAttr("doit-console_budget.test", "currency", "EUR"),
+					resource.TestCheckResourceAttr("doit-console_budget.test", "alerts.#", "1"),
+					resource.TestCheckResourceAttr("doit-console_budget.test", "recipients.0", "finops@example.com"),
+					resource.TestCheckResourceAttr("doit-console_budget.test", "recipients_slack_channels.0.id", "C123"),
+					resource.TestCheckResourceAttr("doit-console_budget.test", "scope.#", "2"),
+					resource.TestCheckResourceAttr("doit-console_budget.test", "growth_per_period", "5"),
+					testAccCheckObject(server, "doit-console_budget.test", fakedoit.Budgets, "amount", 2000),
+					testAccCheckObject(server, "doit-console_budget.test", fakedoit.Budgets, "timeInterval", "quarter"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("doit-console_budget.test", plancheck.ResourceActionUpdate),
+						testAccExpectKnownValue("doit-console_budget.test", "id"),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Recreate after deletion outside of Terraform testing
+			{
+				PreConfig: testAccDeleteOutOfBand(server, fakedoit.Budgets, &id),
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_budget" "test" {
+  name          = "test budget updated"
+  description   = "updated description"
+  amount        = 2000
+  currency      = "EUR"
+  metric        = "amortized_cost"
+  start_period  = 1704067200000
+  time_interval = "quarter"
+  type          = "recurring"
+  scope         = ["attribution-1", "attribution-2"]
+  alerts        = [{ percentage = 80 }]
+  recipients    = ["finops@example.com"]
+  recipients_slack_channels = [{ id = "C123", name = "finops" }]
+  growth_per_period = 5
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIDChanged("doit-console_budget.test", &id),
+					testAccCheckObject(server, "doit-console_budget.test", fakedoit.Budgets, "name", "test budget updated"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("doit-console_budget.test", plancheck.ResourceActionCreate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Removing the optional attributes clears them
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_budget" "test" {
+  name          = "test budget updated"
+  amount        = 2000
+  start_period  = 1704067200000
+  time_interval = "quarter"
+  type          = "recurring"
+  scope         = ["attribution-1", "attribution-2"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("doit-console_budget.test", "description"),
+					resource.TestCheckNoResourceAttr("doit-console_budget.test", "currency"),
+					resource.TestCheckNoResourceAttr("doit-console_budget.test", "growth_per_period"),
+					resource.TestCheckNoResourceAttr("doit-console_budget.test", "alerts"),
+					resource.TestCheckNoResourceAttr("doit-console_budget.test", "recipients"),
+					testAccCheckObject(server, "doit-console_budget.test", fakedoit.Budgets, "description", nil),
+					testAccCheckObject(server, "doit-console_budget.test", fakedoit.Budgets, "growthPerPeriod", nil),
+					testAccCheckObject(server, "doit-console_budget.test", fakedoit.Budgets, "recipientsSlackChannels", nil),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("doit-console_budget.test", plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccBudgetResource_invalidConfig(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_budget" "test" {
+  name          = "test budget"
+  start_period  = 1704067200000
+  time_interval = "monthly"
+  type          = "recurring"
+  scope         = ["attribution-1"]
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Attribute time_interval value must be one of`),
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_budget" "test" {
+  name          = "test budget"
+  start_period  = 1704067200000
+  time_interval = "month"
+  type          = "Fixed"
+  scope         = ["attribution-1"]
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Attribute type value must be one of`),
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_budget" "test" {
+  name          = "test budget"
+  metric        = "usage"
+  start_period  = 1704067200000
+  time_interval = "month"
+  type          = "fixed"
+  scope         = ["attribution-1"]
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Attribute metric value must be one of`),
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_budget" "test" {
+  name          = "test budget"
+  start_period  = 1704067200000
+  time_interval = "month"
+  type          = "fixed"
+  scope         = ["attribution-1"]
+  alerts        = [{ percentage = 25 }, { percentage = 50 }, { percentage = 75 }, { percentage = 100 }]
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Attribute alerts list must contain at most 3 elements`),
+			},
+		},
+	})
+}
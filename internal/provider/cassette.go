@@ -0,0 +1,275 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Cassette modes, selected with the DOIT_VCR_MODE environment variable.
+const (
+	// CassetteRecord sends the requests to the DoiT API and records the
+	// interactions to the cassette file.
+	CassetteRecord = "record"
+	// CassetteReplay answers the requests with the interactions of the
+	// cassette file, without any network access.
+	CassetteReplay = "replay"
+)
+
+// Placeholders replacing the credentials in the recorded interactions.
+const (
+	redactedAPIToken        = "REDACTED_API_TOKEN"
+	redactedCustomerContext = "REDACTED_CUSTOMER_CONTEXT"
+)
+
+// cassetteHeaders are the response headers kept in the recorded interactions.
+var cassetteHeaders = []string{"Content-Type", "Retry-After", "X-Request-Id"}
+
+// cassette is the content of a cassette file.
+type cassette struct {
+	Interactions []cassetteInteraction `json:"interactions"`
+}
+
+// cassetteInteraction is a recorded request and its response.
+type cassetteInteraction struct {
+	Request  cassetteRequest  `json:"request"`
+	Response cassetteResponse `json:"response"`
+}
+
+type cassetteRequest struct {
+	Method string `json:"method"`
+	// URL Path and query of the request, without the host.
+	URL  string `json:"url"`
+	Body string `json:"body,omitempty"`
+}
+
+type cassetteResponse struct {
+	StatusCode int               `json:"status_code"`
+	Header     map[string]string `json:"header,omitempty"`
+	Body       string            `json:"body,omitempty"`
+}
+
+// cassetteTransport records the DoiT API interactions to a cassette file, or
+// replays them from it.
+type cassetteTransport struct {
+	mode string
+	next http.RoundTripper
+	// scrubber replaces the credentials with placeholders.
+	scrubber *strings.Replacer
+	file     *cassetteFile
+}
+
+// cassetteFile holds the interactions of a cassette file. Terraform
+// configures the provider, and so creates a client and its transport, several
+// times per run: the transports of a process share the cassetteFile of their
+// mode and path.
+type cassetteFile struct {
+	path string
+
+	mu       sync.Mutex
+	cassette cassette
+	// replayed flags the interactions already used in replay mode.
+	replayed []bool
+}
+
+// cassetteKey identifies the cassetteFile shared by the transports.
+type cassetteKey struct {
+	mode string
+	path string
+}
+
+var (
+	cassetteFilesMu sync.Mutex
+	cassetteFiles   = map[cassetteKey]*cassetteFile{}
+)
+
+// openCassetteFile returns the cassetteFile of mode and path, loading the
+// cassette file when it is first opened by the process. In record mode the
+// interactions are appended to the existing cassette, if any, so that the
+// provider processes of a Terraform run record to the same cassette.
+func openCassetteFile(mode, path string) (*cassetteFile, error) {
+	cassetteFilesMu.Lock()
+	defer cassetteFilesMu.Unlock()
+
+	key := cassetteKey{mode: mode, path: filepath.Clean(path)}
+	if f, ok := cassetteFiles[key]; ok {
+		return f, nil
+	}
+
+	f := &cassetteFile{path: path}
+	b, err := os.ReadFile(path)
+	switch {
+	case os.IsNotExist(err) && mode == CassetteRecord:
+	case err != nil:
+		return nil, fmt.Errorf("reading cassette: %w", err)
+	default:
+		if err := json.Unmarshal(b, &f.cassette); err != nil {
+			return nil, fmt.Errorf("parsing cassette %s: %w", path, err)
+		}
+	}
+	f.replayed = make([]bool, len(f.cassette.Interactions))
+
+	cassetteFiles[key] = f
+	return f, nil
+}
+
+// newCassetteTransport returns a transport recording to or replaying from the
+// cassette file at path. next sends the requests in record mode.
+func newCassetteTransport(mode, path string, next http.RoundTripper, apiToken, customerContext string) (*cassetteTransport, error) {
+	if path == "" {
+		return nil, fmt.Errorf("DOIT_VCR_CASSETTE must be set to the cassette file path when DOIT_VCR_MODE is set")
+	}
+
+	var secrets []string
+	for _, secret := range []struct{ value, placeholder string }{
+		{apiToken, redactedAPIToken},
+		{customerContext, redactedCustomerContext},
+	} {
+		if secret.value == "" {
+			continue
+		}
+		secrets = append(secrets, secret.value, secret.placeholder)
+		if escaped := url.QueryEscape(secret.value); escaped != secret.value {
+			secrets = append(secrets, escaped, secret.placeholder)
+		}
+	}
+
+	if mode != CassetteRecord && mode != CassetteReplay {
+		return nil, fmt.Errorf("invalid DOIT_VCR_MODE %q, must be %q or %q", mode, CassetteRecord, CassetteReplay)
+	}
+	file, err := openCassetteFile(mode, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cassetteTransport{
+		mode:     mode,
+		next:     next,
+		scrubber: strings.NewReplacer(secrets...),
+		file:     file,
+	}, nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *cassetteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := requestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	request := cassetteRequest{
+		Method: req.Method,
+		URL:    t.scrubber.Replace(req.URL.RequestURI()),
+		Body:   t.scrubber.Replace(string(body)),
+	}
+
+	if t.mode == CassetteReplay {
+		return t.file.replay(req, request)
+	}
+	return t.record(req, request)
+}
+
+// replay returns the response of the first interaction matching request
+// which wasn't replayed yet.
+func (f *cassetteFile) replay(req *http.Request, request cassetteRequest) (*http.Response, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i, interaction := range f.cassette.Interactions {
+		if f.replayed[i] || interaction.Request != request {
+			continue
+		}
+		f.replayed[i] = true
+
+		res := &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        http.Header{},
+			Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}
+		for key, value := range interaction.Response.Header {
+			res.Header.Set(key, value)
+		}
+		return res, nil
+	}
+
+	return nil, fmt.Errorf("cassette %s has no interaction left for %s %s", f.path, request.Method, request.URL)
+}
+
+// record sends the request and appends the interaction to the cassette file.
+func (t *cassetteTransport) record(req *http.Request, request cassetteRequest) (*http.Response, error) {
+	res, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(body))
+
+	response := cassetteResponse{
+		StatusCode: res.StatusCode,
+		Header:     map[string]string{},
+		Body:       t.scrubber.Replace(string(body)),
+	}
+	for _, key := range cassetteHeaders {
+		if value := res.Header.Get(key); value != "" {
+			response.Header[key] = t.scrubber.Replace(value)
+		}
+	}
+
+	if err := t.file.append(cassetteInteraction{Request: request, Response: response}); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// append adds an interaction to the cassette and saves it. The cassette is
+// saved after every interaction as the provider is not notified before
+// exiting.
+func (f *cassetteFile) append(interaction cassetteInteraction) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.cassette.Interactions = append(f.cassette.Interactions, interaction)
+	b, err := json.MarshalIndent(f.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(f.path), 0o755); err != nil {
+		return fmt.Errorf("writing cassette: %w", err)
+	}
+	if err := os.WriteFile(f.path, b, 0o644); err != nil {
+		return fmt.Errorf("writing cassette: %w", err)
+	}
+	return nil
+}
+
+// requestBody returns the body of req without consuming it.
+func requestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if req.GetBody == nil {
+		return nil, fmt.Errorf("cassette: the body of %s %s cannot be read twice", req.Method, req.URL.Path)
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	return io.ReadAll(body)
+}
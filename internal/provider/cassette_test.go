@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"terraform-provider-doit-console/internal/fakedoit"
+)
+
+func TestCassetteRecordReplay(t *testing.T) {
+	server := fakedoit.New(testAccToken)
+	host := server.URL
+	token := testAccToken
+	customerContext := testAccCustomerContext
+	path := filepath.Join(t.TempDir(), "cassettes", "attribution.json")
+	t.Setenv("DOIT_VCR_CASSETTE", path)
+	attribution := Attribution{
+		Name:       "recorded",
+		Formula:    "A",
+		Components: []Component{{TypeComponent: "fixed", Key: "cloud_provider", Values: []string{"google-cloud"}}},
+	}
+
+	// Record the interactions with the API through two clients, as Terraform
+	// configures the provider several times per run.
+	t.Setenv("DOIT_VCR_MODE", CassetteRecord)
+	first, err := NewClientTest(context.Background(), &host, &token, &customerContext, nil)
+	if err != nil {
+		t.Fatalf("creating first recording client: %s", err)
+	}
+	second, err := NewClientTest(context.Background(), &host, &token, &customerContext, nil)
+	if err != nil {
+		t.Fatalf("creating second recording client: %s", err)
+	}
+	created, err := first.Analytics.CreateAttribution(context.Background(), attribution)
+	if err != nil {
+		t.Fatalf("creating attribution: %s", err)
+	}
+	if _, err := second.Analytics.GetAttribution(context.Background(), created.Id); err != nil {
+		t.Fatalf("reading attribution: %s", err)
+	}
+
+	// A new provider process appends to the cassette.
+	cassetteFilesMu.Lock()
+	cassetteFiles = map[cassetteKey]*cassetteFile{}
+	cassetteFilesMu.Unlock()
+	third, err := NewClientTest(context.Background(), &host, &token, &customerContext, nil)
+	if err != nil {
+		t.Fatalf("creating third recording client: %s", err)
+	}
+	_, err = third.Analytics.GetAttribution(context.Background(), "missing")
+	if !IsNotFound(err) {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+	server.Close()
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading cassette: %s", err)
+	}
+	for _, secret := range []string{testAccToken, testAccCustomerContext} {
+		if strings.Contains(string(b), secret) {
+			t.Errorf("cassette contains %q:\n%s", secret, b)
+		}
+	}
+	var recorded cassette
+	if err := json.Unmarshal(b, &recorded); err != nil {
+		t.Fatalf("parsing cassette: %s", err)
+	}
+	var attributionRequests int
+	for _, interaction := range recorded.Interactions {
+		if strings.Contains(interaction.Request.URL, "/attributions") {
+			attributionRequests++
+		}
+	}
+	if attributionRequests != 3 {
+		t.Errorf("expected the 3 attribution requests of every client in the cassette, got %d:\n%s", attributionRequests, b)
+	}
+
+	// Replay them through two clients without the API, with other
+	// credentials.
+	t.Setenv("DOIT_VCR_MODE", CassetteReplay)
+	otherToken := "other-token"
+	otherCustomerContext := "other-customer"
+	first, err = NewClientTest(context.Background(), &host, &otherToken, &otherCustomerContext, &RetryConfig{})
+	if err != nil {
+		t.Fatalf("creating first replaying client: %s", err)
+	}
+	second, err = NewClientTest(context.Background(), &host, &otherToken, &otherCustomerContext, &RetryConfig{})
+	if err != nil {
+		t.Fatalf("creating second replaying client: %s", err)
+	}
+	replayed, err := first.Analytics.CreateAttribution(context.Background(), attribution)
+	if err != nil {
+		t.Fatalf("replaying attribution creation: %s", err)
+	}
+	if replayed.Id != created.Id {
+		t.Errorf("expected replayed attribution id %s, got %s", created.Id, replayed.Id)
+	}
+	read, err := second.Analytics.GetAttribution(context.Background(), created.Id)
+	if err != nil {
+		t.Fatalf("replaying attribution read: %s", err)
+	}
+	if read.Name != attribution.Name {
+		t.Errorf("expected replayed attribution name %s, got %s", attribution.Name, read.Name)
+	}
+	_, err = second.Analytics.GetAttribution(context.Background(), "missing")
+	if !IsNotFound(err) {
+		t.Errorf("expected a replayed not found error, got %v", err)
+	}
+
+	// Every interaction is replayed once, whichever client sends it.
+	_, err = first.Analytics.GetAttribution(context.Background(), "missing")
+	if err == nil || IsNotFound(err) {
+		t.Errorf("expected an error for a request missing from the cassette, got %v", err)
+	}
+}
+
+func TestCassetteInvalidMode(t *testing.T) {
+	t.Setenv("DOIT_VCR_MODE", "rewind")
+	t.Setenv("DOIT_VCR_CASSETTE", filepath.Join(t.TempDir(), "cassette.json"))
+	host := "http://127.0.0.1:0"
+	token := testAccToken
+	customerContext := testAccCustomerContext
+	if _, err := NewClientTest(context.Background(), &host, &token, &customerContext, nil); err == nil {
+		t.Fatal("expected an error for an invalid DOIT_VCR_MODE")
+	}
+}
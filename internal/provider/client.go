@@ -1,12 +1,29 @@
 package provider
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
-	"io/ioutil"
+	"io"
+	"math"
+	"math/rand"
 	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
 	"time"
 )
 
+// Default retry settings used when the provider configuration does not
+// override them.
+const (
+	DefaultMaxRetries   int64 = 3
+	DefaultRetryWaitMin int64 = 1
+	DefaultRetryWaitMax int64 = 30
+)
+
 type AuthResponseTest struct {
 	DoiTAPITOken    string `json:"doiTAPITOken"`
 	CustomerContext string `json:"customerContext"`
@@ -18,30 +35,89 @@ type AuthStructTest struct {
 	CustomerContext string `json:"customerContext"`
 }
 
+// RetryConfig controls how doRequest retries transient DoiT API failures.
+type RetryConfig struct {
+	// MaxRetries is the number of retries after the first attempt.
+	// Zero disables retries.
+	MaxRetries int
+	// WaitMin is the backoff before the first retry. It doubles on every
+	// following attempt.
+	WaitMin time.Duration
+	// WaitMax caps the backoff, including the one requested by a
+	// Retry-After header.
+	WaitMax time.Duration
+	// Jitter randomizes each backoff between zero and the computed value
+	// to avoid many clients retrying in lockstep.
+	Jitter bool
+}
+
+// DefaultRetryConfig returns the retry settings used when none are configured.
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxRetries: int(DefaultMaxRetries),
+		WaitMin:    time.Duration(DefaultRetryWaitMin) * time.Second,
+		WaitMax:    time.Duration(DefaultRetryWaitMax) * time.Second,
+		Jitter:     true,
+	}
+}
+
+// DefaultRequestTimeout limits every attempt of a request.
+const DefaultRequestTimeout = 30 * time.Second
+
 // Client
 type ClientTest struct {
 	HostURL    string
 	HTTPClient *http.Client
 	Auth       AuthStructTest
+	Retry      RetryConfig
+	// RequestTimeout limits every attempt of a request. The attempts of a
+	// request with a deadline, such as the resource operations limited by
+	// their timeouts, are limited to the time left before the deadline when
+	// it is shorter, so a hung attempt is retried within the deadline.
+	RequestTimeout time.Duration
+	// Analytics groups the endpoints of the analytics API.
+	Analytics *AnalyticsClient
+	// Anomalies groups the endpoints of the anomalies API.
+	Anomalies *AnomaliesClient
 }
 
 // NewClient -
-func NewClientTest(host, doiTAPIClient, customerContext *string) (*ClientTest, error) {
+func NewClientTest(ctx context.Context, host, doiTAPIClient, customerContext *string, retry *RetryConfig) (*ClientTest, error) {
 	c := ClientTest{
-		HTTPClient: &http.Client{Timeout: 30 * time.Second},
+		HTTPClient:     &http.Client{},
+		RequestTimeout: DefaultRequestTimeout,
 		// Default DoiT URL
 		HostURL: HostURL,
 		Auth: AuthStructTest{
 			DoiTAPITOken:    *doiTAPIClient,
 			CustomerContext: *customerContext,
 		},
+		Retry: DefaultRetryConfig(),
 	}
 
 	if host != nil {
 		c.HostURL = *host
 	}
 
-	_, err := c.SignIn()
+	if retry != nil {
+		c.Retry = *retry
+	}
+	c.Analytics = &AnalyticsClient{client: &c}
+	c.Anomalies = &AnomaliesClient{client: &c}
+
+	// Record or replay the API interactions, see cassetteTransport.
+	transport := http.DefaultTransport
+	if mode := os.Getenv("DOIT_VCR_MODE"); mode != "" {
+		cassette, err := newCassetteTransport(mode, os.Getenv("DOIT_VCR_CASSETTE"), http.DefaultTransport,
+			c.Auth.DoiTAPITOken, c.Auth.CustomerContext)
+		if err != nil {
+			return nil, err
+		}
+		transport = cassette
+	}
+	c.HTTPClient.Transport = &loggingTransport{transport: transport, token: c.Auth.DoiTAPITOken}
+
+	_, err := c.SignIn(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -49,7 +125,7 @@ func NewClientTest(host, doiTAPIClient, customerContext *string) (*ClientTest, e
 	return &c, nil
 }
 
-func (c *ClientTest) SignIn() (*AuthResponseTest, error) {
+func (c *ClientTest) SignIn(ctx context.Context) (*AuthResponseTest, error) {
 	if c.Auth.DoiTAPITOken == "" {
 		return nil, fmt.Errorf("define Doit API Token")
 	}
@@ -58,8 +134,7 @@ func (c *ClientTest) SignIn() (*AuthResponseTest, error) {
 	//	return nil, err
 	//}
 
-	url_ccontext := "/?customerContext=" + c.Auth.CustomerContext
-	req, err := http.NewRequest("GET", c.HostURL+url_ccontext, nil)
+	req, err := c.newRequest(ctx, http.MethodGet, "/", nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -81,44 +156,341 @@ func (c *ClientTest) SignIn() (*AuthResponseTest, error) {
 	return &ar, nil
 }
 
-func (c *ClientTest) doRequest(req *http.Request) ([]byte, error) {
-	//req.Header.Set("Authorization", c.Token)
-	req.Header.Set("Authorization", "Bearer "+c.Auth.DoiTAPITOken)
-	res, err := c.HTTPClient.Do(req)
+// newRequest builds a request to the DoiT API. path is relative to HostURL
+// and its segments must already be escaped, see analyticsPath. The customer
+// context is added to query. body, unless nil, is sent as JSON.
+func (c *ClientTest) newRequest(ctx context.Context, method, path string, query url.Values, body interface{}) (*http.Request, error) {
+	values := url.Values{}
+	for key, value := range query {
+		values[key] = value
+	}
+	values.Set("customerContext", c.Auth.CustomerContext)
+
+	var reader io.Reader
+	if body != nil {
+		rb, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(rb)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.HostURL+path+"?"+values.Encode(), reader)
 	if err != nil {
 		return nil, err
 	}
-	defer res.Body.Close()
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return req, nil
+}
 
-	body, err := ioutil.ReadAll(res.Body)
+// patchBody returns the body of a PATCH request updating an object to value.
+// The API keeps the fields left out of a PATCH, so the optional fields which
+// value omits as empty are sent as null to clear them. fields are the JSON
+// names of these fields, with a dot after the name of a nested object, e.g.
+// "config.currency".
+func patchBody(value interface{}, fields ...string) (map[string]interface{}, error) {
+	b, err := json.Marshal(value)
 	if err != nil {
 		return nil, err
 	}
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	body := map[string]interface{}{}
+	if err := decoder.Decode(&body); err != nil {
+		return nil, err
+	}
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated {
-		return nil, fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
+	for _, field := range fields {
+		object := body
+		names := strings.Split(field, ".")
+		for _, name := range names[:len(names)-1] {
+			object, _ = object[name].(map[string]interface{})
+		}
+		if object == nil {
+			continue
+		}
+		if _, ok := object[names[len(names)-1]]; !ok {
+			object[names[len(names)-1]] = nil
+		}
 	}
+	return body, nil
+}
+
+// emptyResponse is the response type of the requests whose response body is
+// ignored, such as the deletions.
+type emptyResponse struct{}
 
-	return body, err
+func (*emptyResponse) UnmarshalJSON([]byte) error {
+	return nil
 }
 
-func (c *ClientTest) createAttribution(req *http.Request) ([]byte, error) {
-	req.Header.Set("Authorization", c.Auth.DoiTAPITOken)
+// do sends a request to the DoiT API, see newRequest, and decodes its JSON
+// response into a T. The requests with a non-idempotent method are only
+// retried when ctx was marked with withRetrySafe.
+func do[T any](ctx context.Context, c *ClientTest, method, path string, query url.Values, body interface{}) (*T, error) {
+	req, err := c.newRequest(ctx, method, path, query, body)
+	if err != nil {
+		return nil, err
+	}
 
-	res, err := c.HTTPClient.Do(req)
+	resBody, err := c.doRequest(req)
 	if err != nil {
 		return nil, err
 	}
+
+	result := new(T)
+	if len(bytes.TrimSpace(resBody)) == 0 {
+		return result, nil
+	}
+	err = json.Unmarshal(resBody, result)
+	if err != nil {
+		return nil, fmt.Errorf("decoding the response of %s %s: %w", method, path, err)
+	}
+	return result, nil
+}
+
+func (c *ClientTest) doRequest(req *http.Request) ([]byte, error) {
+	//req.Header.Set("Authorization", c.Token)
+	req.Header.Set("Authorization", "Bearer "+c.Auth.DoiTAPITOken)
+	retryable := isRetryableRequest(req)
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		res, body, err := c.send(req)
+		if err != nil {
+			if retryable && attempt < c.Retry.MaxRetries {
+				if err := sleepContext(req.Context(), c.Retry.backoff(attempt, nil)); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			return nil, err
+		}
+
+		if res.StatusCode == http.StatusOK || res.StatusCode == http.StatusCreated {
+			return body, nil
+		}
+
+		if retryable && attempt < c.Retry.MaxRetries && isRetryableStatus(res.StatusCode) {
+			if err := sleepContext(req.Context(), c.Retry.backoff(attempt, res)); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		return nil, newAPIError(res, body)
+	}
+}
+
+// send sends a single attempt of req and reads the response body, limiting
+// the attempt to the shortest of RequestTimeout and the time left before the
+// deadline of the context of req.
+func (c *ClientTest) send(req *http.Request) (*http.Response, []byte, error) {
+	timeout := c.RequestTimeout
+	if deadline, ok := req.Context().Deadline(); ok {
+		if left := time.Until(deadline); timeout <= 0 || left < timeout {
+			timeout = left
+		}
+	}
+	if timeout > 0 {
+		ctx, cancel := context.WithTimeout(req.Context(), timeout)
+		defer cancel()
+		req = req.WithContext(ctx)
+	}
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
 	defer res.Body.Close()
 
-	body, err := ioutil.ReadAll(res.Body)
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	return res, body, nil
+}
+
+// ListOptions - Options for the paginated list endpoints
+type ListOptions struct {
+	// MaxResults Maximum number of results per page. The API default is
+	// used when zero.
+	MaxResults int64
+	// Filters Server side filters, sent as "key:value" pairs joined by "|"
+	Filters []ListFilter
+	// MinCreationTime Only return items created after this time, in
+	// milliseconds since the epoch. Ignored when zero.
+	MinCreationTime int64
+	// MaxCreationTime Only return items created before this time, in
+	// milliseconds since the epoch. Ignored when zero.
+	MaxCreationTime int64
+}
+
+// ListFilter - A server side filter of a list endpoint
+type ListFilter struct {
+	Key   string
+	Value string
+}
+
+// listPages calls the list endpoint at path once per page until the API
+// stops returning a page token. handlePage decodes a page and returns the
+// token of the next one.
+func (c *ClientTest) listPages(ctx context.Context, path string, opts ListOptions, handlePage func(body []byte) (string, error)) error {
+	pageToken := ""
+	for {
+		query := url.Values{}
+		if opts.MaxResults > 0 {
+			query.Set("maxResults", strconv.FormatInt(opts.MaxResults, 10))
+		}
+		if len(opts.Filters) > 0 {
+			filters := make([]string, 0, len(opts.Filters))
+			for _, filter := range opts.Filters {
+				filters = append(filters, filter.Key+":"+filter.Value)
+			}
+			query.Set("filter", strings.Join(filters, "|"))
+		}
+		if opts.MinCreationTime > 0 {
+			query.Set("minCreationTime", strconv.FormatInt(opts.MinCreationTime, 10))
+		}
+		if opts.MaxCreationTime > 0 {
+			query.Set("maxCreationTime", strconv.FormatInt(opts.MaxCreationTime, 10))
+		}
+		if pageToken != "" {
+			query.Set("pageToken", pageToken)
+		}
+
+		req, err := c.newRequest(ctx, http.MethodGet, path, query, nil)
+		if err != nil {
+			return err
+		}
+
+		body, err := c.doRequest(req)
+		if err != nil {
+			return err
+		}
+
+		pageToken, err = handlePage(body)
+		if err != nil {
+			return err
+		}
+		if pageToken == "" {
+			return nil
+		}
+	}
+}
+
+// listAll returns the items of every page of the list endpoint at path, see
+// listPages. Each page is decoded into a P and items returns its items and
+// the token of the next page.
+func listAll[P, T any](ctx context.Context, c *ClientTest, path string, opts ListOptions, items func(page *P) ([]T, string)) ([]T, error) {
+	all := []T{}
+	err := c.listPages(ctx, path, opts, func(body []byte) (string, error) {
+		page := new(P)
+		if err := json.Unmarshal(body, page); err != nil {
+			return "", fmt.Errorf("decoding the response of %s %s: %w", http.MethodGet, path, err)
+		}
+		pageItems, pageToken := items(page)
+		all = append(all, pageItems...)
+		return pageToken, nil
+	})
 	if err != nil {
 		return nil, err
 	}
+	return all, nil
+}
+
+// retrySafeKey is the context key used by withRetrySafe.
+type retrySafeKey struct{}
+
+// withRetrySafe marks the requests sent with ctx as safe to retry, for the
+// requests with a non-idempotent method such as a read-only POST query.
+func withRetrySafe(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retrySafeKey{}, true)
+}
+
+// isRetryableRequest reports whether req may be sent more than once.
+func isRetryableRequest(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	safe, _ := req.Context().Value(retrySafeKey{}).(bool)
+	return safe
+}
+
+// isRetryableStatus reports whether the status code signals a transient failure.
+func isRetryableStatus(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// backoff returns how long to wait before retry number attempt+1. A
+// Retry-After header on res takes precedence over the exponential backoff,
+// up to WaitMax so that the API cannot stall the operation.
+func (r RetryConfig) backoff(attempt int, res *http.Response) time.Duration {
+	if res != nil {
+		if wait, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok {
+			if wait > r.WaitMax {
+				wait = r.WaitMax
+			}
+			return wait
+		}
+	}
 
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
+	wait := time.Duration(float64(r.WaitMin) * math.Pow(2, float64(attempt)))
+	if wait > r.WaitMax || wait < 0 {
+		wait = r.WaitMax
 	}
+	if r.Jitter && wait > 0 {
+		wait = time.Duration(rand.Int63n(int64(wait) + 1))
+	}
+	return wait
+}
 
-	return body, err
+// parseRetryAfter parses a Retry-After header given either as a number of
+// seconds or as an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+// sleepContext waits for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
 }
@@ -0,0 +1,245 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"terraform-provider-doit-console/internal/fakedoit"
+)
+
+func TestRetryConfigBackoff(t *testing.T) {
+	config := RetryConfig{WaitMin: time.Second, WaitMax: 30 * time.Second}
+	tests := []struct {
+		attempt    int
+		retryAfter string
+		expected   time.Duration
+	}{
+		{attempt: 0, expected: time.Second},
+		{attempt: 1, expected: 2 * time.Second},
+		{attempt: 3, expected: 8 * time.Second},
+		{attempt: 5, expected: 30 * time.Second},
+		// Overflows are capped too.
+		{attempt: 100, expected: 30 * time.Second},
+		{attempt: 0, retryAfter: "7", expected: 7 * time.Second},
+		{attempt: 3, retryAfter: "0", expected: 0},
+		// The API cannot stall the operation longer than WaitMax.
+		{attempt: 0, retryAfter: "3600", expected: 30 * time.Second},
+		{attempt: 2, retryAfter: "invalid", expected: 4 * time.Second},
+	}
+	for _, tt := range tests {
+		res := &http.Response{Header: http.Header{}}
+		if tt.retryAfter != "" {
+			res.Header.Set("Retry-After", tt.retryAfter)
+		}
+		if wait := config.backoff(tt.attempt, res); wait != tt.expected {
+			t.Errorf("backoff(%d, Retry-After %q): expected %s, got %s", tt.attempt, tt.retryAfter, tt.expected, wait)
+		}
+	}
+
+	config.Jitter = true
+	for attempt := 0; attempt < 10; attempt++ {
+		if wait := config.backoff(attempt, nil); wait < 0 || wait > config.WaitMax {
+			t.Errorf("backoff(%d) with jitter: %s is not between 0 and %s", attempt, wait, config.WaitMax)
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected time.Duration
+		ok       bool
+	}{
+		{value: ""},
+		{value: "abc"},
+		{value: "-1"},
+		{value: "0", ok: true},
+		{value: "120", expected: 2 * time.Minute, ok: true},
+		{value: time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat), ok: true},
+	}
+	for _, tt := range tests {
+		wait, ok := parseRetryAfter(tt.value)
+		if ok != tt.ok || wait != tt.expected {
+			t.Errorf("parseRetryAfter(%q): expected %s, %t, got %s, %t", tt.value, tt.expected, tt.ok, wait, ok)
+		}
+	}
+
+	// HTTP dates have a one second precision.
+	wait, ok := parseRetryAfter(time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+	if !ok || wait < 58*time.Second || wait > time.Minute {
+		t.Errorf("parseRetryAfter(in a minute): expected about a minute, got %s, %t", wait, ok)
+	}
+}
+
+func TestIsRetryableStatus(t *testing.T) {
+	tests := map[int]bool{
+		http.StatusOK:                  false,
+		http.StatusBadRequest:          false,
+		http.StatusNotFound:            false,
+		http.StatusConflict:            false,
+		http.StatusTooManyRequests:     true,
+		http.StatusInternalServerError: false,
+		http.StatusBadGateway:          true,
+		http.StatusServiceUnavailable:  true,
+		http.StatusGatewayTimeout:      true,
+	}
+	for status, expected := range tests {
+		if retryable := isRetryableStatus(status); retryable != expected {
+			t.Errorf("isRetryableStatus(%d): expected %t, got %t", status, expected, retryable)
+		}
+	}
+}
+
+func TestIsRetryableRequest(t *testing.T) {
+	tests := []struct {
+		method    string
+		retrySafe bool
+		expected  bool
+	}{
+		{method: http.MethodGet, expected: true},
+		{method: http.MethodPut, expected: true},
+		{method: http.MethodDelete, expected: true},
+		{method: http.MethodPost, expected: false},
+		{method: http.MethodPatch, expected: false},
+		{method: http.MethodPost, retrySafe: true, expected: true},
+	}
+	for _, tt := range tests {
+		ctx := context.Background()
+		if tt.retrySafe {
+			ctx = withRetrySafe(ctx)
+		}
+		req, err := http.NewRequestWithContext(ctx, tt.method, "https://api.doit.com/", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if retryable := isRetryableRequest(req); retryable != tt.expected {
+			t.Errorf("isRetryableRequest(%s, retry safe %t): expected %t, got %t", tt.method, tt.retrySafe, tt.expected, retryable)
+		}
+	}
+}
+
+func TestDoRequestRetries(t *testing.T) {
+	server := testAccServer(t)
+	id := server.Put(testAccCustomerContext, fakedoit.Attributions, map[string]any{"name": "retried"})
+	// Fail instead of hanging if a Retry-After is not capped.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	host, token, customerContext := server.URL, testAccToken, testAccCustomerContext
+	client, err := NewClientTest(ctx, &host, &token, &customerContext, &RetryConfig{
+		MaxRetries: 2,
+		WaitMin:    time.Millisecond,
+		WaitMax:    10 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// attempts returns the statuses of the requests to path since the
+	// previous call.
+	seen := len(server.Requests())
+	attempts := func(path string) []int {
+		requests := server.Requests()
+		var statuses []int
+		for _, request := range requests[seen:] {
+			if request.Path == path {
+				statuses = append(statuses, request.Status)
+			}
+		}
+		seen = len(requests)
+		return statuses
+	}
+	path := "/analytics/v1/attributions/" + id
+
+	// The transient failures are retried, honoring Retry-After up to WaitMax.
+	server.InjectFault(fakedoit.Fault{Method: http.MethodGet, Path: path, Status: http.StatusTooManyRequests,
+		Header: http.Header{"Retry-After": {"3600"}}, Times: 1})
+	server.InjectFault(fakedoit.Fault{Method: http.MethodGet, Path: path, Status: http.StatusServiceUnavailable, Times: 1})
+	attribution, err := client.Analytics.GetAttribution(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attribution.Name != "retried" {
+		t.Errorf("expected the attribution to be returned after the retries, got %+v", attribution)
+	}
+	if statuses := attempts(path); !equalInts(statuses, []int{429, 503, 200}) {
+		t.Errorf("expected statuses 429, 503, 200, got %v", statuses)
+	}
+
+	// The retries stop after MaxRetries.
+	server.InjectFault(fakedoit.Fault{Method: http.MethodGet, Path: path, Status: http.StatusBadGateway, Times: 5})
+	_, err = client.Analytics.GetAttribution(ctx, id)
+	if err == nil {
+		t.Fatal("expected an error once the retries are exhausted")
+	}
+	if statuses := attempts(path); !equalInts(statuses, []int{502, 502, 502}) {
+		t.Errorf("expected 3 attempts, got %v", statuses)
+	}
+	server.ClearFaults()
+
+	// A hung attempt is retried after RequestTimeout, even though ctx has a
+	// later deadline.
+	client.RequestTimeout = 100 * time.Millisecond
+	server.InjectFault(fakedoit.Fault{Method: http.MethodGet, Path: path, Delay: time.Minute, Times: 1})
+	if _, err := client.Analytics.GetAttribution(ctx, id); err != nil {
+		t.Fatalf("expected the hung attempt to be retried, got %v", err)
+	}
+	if statuses := attempts(path); len(statuses) != 2 {
+		t.Errorf("expected 2 attempts, got %v", statuses)
+	}
+	client.RequestTimeout = DefaultRequestTimeout
+
+	// Errors which are not transient are not retried.
+	_, err = client.Analytics.GetAttribution(ctx, "missing")
+	if !IsNotFound(err) {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+	if statuses := attempts("/analytics/v1/attributions/missing"); !equalInts(statuses, []int{404}) {
+		t.Errorf("expected a single attempt, got %v", statuses)
+	}
+
+	// A POST creating an object is not retried, it may have been applied.
+	server.InjectFault(fakedoit.Fault{Method: http.MethodPost, Path: "/analytics/v1/attributions", Status: http.StatusServiceUnavailable, Times: 1})
+	_, err = client.Analytics.CreateAttribution(ctx, Attribution{Name: "not retried"})
+	if err == nil {
+		t.Fatal("expected the failed creation not to be retried")
+	}
+	if statuses := attempts("/analytics/v1/attributions"); !equalInts(statuses, []int{503}) {
+		t.Errorf("expected a single attempt, got %v", statuses)
+	}
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestPatchBody(t *testing.T) {
+	body, err := patchBody(Alert{
+		Name: "cleared",
+		Config: AlertConfig{
+			Operator:  "gt",
+			Condition: "forecast",
+		},
+	}, "recipients", "config.condition", "config.currency", "missing.field")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := json.Marshal(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"config":{"condition":"forecast","currency":null,"operator":"gt","timeInterval":"","value":0},"name":"cleared","recipients":null}`
+	if string(b) != expected {
+		t.Errorf("expected %s, got %s", expected, b)
+	}
+}
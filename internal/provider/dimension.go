@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// ListDimensions - Returns all the dimensions matching opts, following the pagination
+func (a *AnalyticsClient) ListDimensions(ctx context.Context, opts ListOptions) ([]DimensionListItem, error) {
+	return listAll(ctx, a.client, analyticsPath("dimensions"), opts, func(page *DimensionList) ([]DimensionListItem, string) {
+		return page.Dimensions, page.PageToken
+	})
+}
+
+// GetDimension - Returns a specific dimension with its values
+func (a *AnalyticsClient) GetDimension(ctx context.Context, dimensionType, id string) (*DimensionDetails, error) {
+	query := url.Values{}
+	query.Set("type", dimensionType)
+	query.Set("id", id)
+	return do[DimensionDetails](ctx, a.client, http.MethodGet, analyticsPath("dimension"), query, nil)
+}
@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// dimensionTypes are the types of the dimensions accepted by the DoiT API,
+// for the dimensions data source and the attribution components.
+var dimensionTypes = []string{
+	"datetime", "fixed", "optional", "label", "tag", "project_label",
+	"system_label", "attribution", "attribution_group", "gke", "gke_label",
+}
+
+// dimensionsDataSourceModel maps the data source schema data.
+type dimensionsDataSourceModel struct {
+	Type          types.String     `tfsdk:"type"`
+	Key           types.String     `tfsdk:"key"`
+	IncludeValues types.Bool       `tfsdk:"include_values"`
+	Dimensions    []dimensionModel `tfsdk:"dimensions"`
+}
+
+// dimensionModel maps dimension data.
+type dimensionModel struct {
+	Type   types.String   `tfsdk:"type"`
+	Key    types.String   `tfsdk:"key"`
+	Label  types.String   `tfsdk:"label"`
+	Values []types.String `tfsdk:"values"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &dimensionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &dimensionsDataSource{}
+)
+
+// NewDimensionsDataSource is a helper function to simplify the provider implementation.
+func NewDimensionsDataSource() datasource.DataSource {
+	return &dimensionsDataSource{}
+}
+
+// dimensionsDataSource is the data source implementation.
+type dimensionsDataSource struct {
+	client *ClientTest
+}
+
+// Metadata returns the data source type name.
+func (d *dimensionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dimensions"
+}
+
+// Schema defines the schema for the data source.
+func (d *dimensionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the dimensions available to the customer, such as the labels and tags " +
+			"keys, to use as attribution components or report filters.",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Description: "Only return the dimensions of this type. " + oneOfDescription(dimensionTypes),
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(dimensionTypes...),
+				},
+			},
+			"key": schema.StringAttribute{
+				Description: "Only return the dimensions with this key, such as \"cloud_provider\" or the key of a label",
+				Optional:    true,
+			},
+			"include_values": schema.BoolAttribute{
+				Description: "Whether to return the values of the dimensions. " +
+					"The values of each dimension are fetched with a separate API request.",
+				Optional: true,
+			},
+			"dimensions": schema.ListNestedAttribute{
+				Description: "List of the dimensions matching the filters",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "Type of the dimension, to use as the type of an attribution component",
+							Computed:    true,
+						},
+						"key": schema.StringAttribute{
+							Description: "Key of the dimension, to use as the key of an attribution component",
+							Computed:    true,
+						},
+						"label": schema.StringAttribute{
+							Description: "Display name of the dimension",
+							Computed:    true,
+						},
+						"values": schema.ListAttribute{
+							Description: "Values of the dimension, only set when include_values is true",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *dimensionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ClientTest)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ClientTest, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dimensionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state dimensionsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := ListOptions{}
+	if state.Type.ValueString() != "" {
+		opts.Filters = append(opts.Filters, ListFilter{Key: "type", Value: state.Type.ValueString()})
+	}
+	dimensions, err := d.client.Analytics.ListDimensions(ctx, opts)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Doit Console Dimensions",
+			"Could not list Doit Console Dimensions: "+err.Error(),
+		)
+		return
+	}
+
+	state.Dimensions = []dimensionModel{}
+	for _, dimension := range dimensions {
+		if !state.Key.IsNull() && dimension.Id != state.Key.ValueString() {
+			continue
+		}
+		model := dimensionModel{
+			Type:  types.StringValue(dimension.Type),
+			Key:   types.StringValue(dimension.Id),
+			Label: types.StringValue(dimension.Label),
+		}
+		if state.IncludeValues.ValueBool() {
+			values, err := d.client.Analytics.GetDimension(ctx, dimension.Type, dimension.Id)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error Reading Doit Console Dimension",
+					"Could not read the values of Doit Console Dimension "+dimension.Type+" "+dimension.Id+": "+err.Error(),
+				)
+				return
+			}
+			model.Values = []types.String{}
+			for _, value := range values.Values {
+				model.Values = append(model.Values, types.StringValue(value.Value))
+			}
+		}
+		state.Dimensions = append(state.Dimensions, model)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
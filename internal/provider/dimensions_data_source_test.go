@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-doit-console/internal/fakedoit"
+)
+
+func TestAccDimensionsDataSource(t *testing.T) {
+	server := testAccServer(t)
+	for _, dimension := range []map[string]any{
+		{"id": "cloud_provider", "type": "fixed", "label": "Cloud", "values": []any{
+			map[string]any{"value": "google-cloud", "cloud": "google-cloud"},
+			map[string]any{"value": "amazon-web-services", "cloud": "amazon-web-services"},
+		}},
+		{"id": "env", "type": "label", "label": "env", "values": []any{
+			map[string]any{"value": "prod"},
+		}},
+		{"id": "env", "type": "tag", "label": "env", "values": []any{
+			map[string]any{"value": "staging"},
+		}},
+	} {
+		server.Put(testAccCustomerContext, fakedoit.Dimensions, dimension)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+data "doit-console_dimensions" "invalid" {
+  type = "labels"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Attribute type value must be one of`),
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+data "doit-console_dimensions" "all" {}
+
+data "doit-console_dimensions" "labels" {
+  type           = "label"
+  include_values = true
+}
+
+data "doit-console_dimensions" "env" {
+  key = "env"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.doit-console_dimensions.all", "dimensions.#", "3"),
+					resource.TestCheckResourceAttr("data.doit-console_dimensions.all", "dimensions.0.type", "fixed"),
+					resource.TestCheckResourceAttr("data.doit-console_dimensions.all", "dimensions.0.key", "cloud_provider"),
+					resource.TestCheckResourceAttr("data.doit-console_dimensions.all", "dimensions.0.label", "Cloud"),
+					resource.TestCheckNoResourceAttr("data.doit-console_dimensions.all", "dimensions.0.values.#"),
+					resource.TestCheckResourceAttr("data.doit-console_dimensions.labels", "dimensions.#", "1"),
+					resource.TestCheckResourceAttr("data.doit-console_dimensions.labels", "dimensions.0.key", "env"),
+					resource.TestCheckResourceAttr("data.doit-console_dimensions.labels", "dimensions.0.values.#", "1"),
+					resource.TestCheckResourceAttr("data.doit-console_dimensions.labels", "dimensions.0.values.0", "prod"),
+					resource.TestCheckResourceAttr("data.doit-console_dimensions.env", "dimensions.#", "2"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// APIError is returned by the client when the DoiT API answers with a
+// non-successful status code.
+type APIError struct {
+	// StatusCode HTTP status code of the response
+	StatusCode int
+	// Code Error code reported by the API, if any
+	Code string
+	// Message Human readable error message reported by the API, if any
+	Message string
+	// RequestID Identifier of the request, useful when contacting DoiT support
+	RequestID string
+	// Body Parsed JSON body of the response. Nil if the body is not JSON.
+	Body map[string]any
+	// RawBody Raw body of the response
+	RawBody []byte
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "status: %d", e.StatusCode)
+	if e.Code != "" {
+		fmt.Fprintf(&b, ", code: %s", e.Code)
+	}
+	if e.Message != "" {
+		fmt.Fprintf(&b, ", message: %s", e.Message)
+	} else {
+		fmt.Fprintf(&b, ", body: %s", e.RawBody)
+	}
+	if e.RequestID != "" {
+		fmt.Fprintf(&b, ", request id: %s", e.RequestID)
+	}
+	return b.String()
+}
+
+// newAPIError builds an APIError from a response and its already read body.
+func newAPIError(res *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: res.StatusCode,
+		RequestID:  res.Header.Get("X-Request-Id"),
+		RawBody:    body,
+	}
+
+	var parsed map[string]any
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return apiErr
+	}
+	apiErr.Body = parsed
+
+	// The API is not consistent on the shape of the error body, so look
+	// at the usual places for the code and the message.
+	if errObj, ok := parsed["error"].(map[string]any); ok {
+		parsed = errObj
+	}
+	apiErr.Code = stringField(parsed, "code", "status")
+	apiErr.Message = stringField(parsed, "message", "error", "detail")
+	if apiErr.RequestID == "" {
+		apiErr.RequestID = stringField(parsed, "requestId", "request_id")
+	}
+
+	return apiErr
+}
+
+// stringField returns the first key of m holding a non empty value,
+// formatted as a string.
+func stringField(m map[string]any, keys ...string) string {
+	for _, key := range keys {
+		switch v := m[key].(type) {
+		case string:
+			if v != "" {
+				return v
+			}
+		case float64:
+			return fmt.Sprintf("%v", v)
+		}
+	}
+	return ""
+}
+
+// IsNotFound reports whether err is an APIError with a 404 status code.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestNewAPIError(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		requestID string
+		body      string
+		expected  APIError
+		message   string
+	}{
+		{
+			name:     "code and message",
+			status:   http.StatusBadRequest,
+			body:     `{"code":"INVALID_ARGUMENT","message":"name is required","requestId":"req-1"}`,
+			expected: APIError{StatusCode: 400, Code: "INVALID_ARGUMENT", Message: "name is required", RequestID: "req-1"},
+			message:  "status: 400, code: INVALID_ARGUMENT, message: name is required, request id: req-1",
+		},
+		{
+			name:     "nested error object",
+			status:   http.StatusForbidden,
+			body:     `{"error":{"status":403,"message":"forbidden","request_id":"req-2"}}`,
+			expected: APIError{StatusCode: 403, Code: "403", Message: "forbidden", RequestID: "req-2"},
+			message:  "status: 403, code: 403, message: forbidden, request id: req-2",
+		},
+		{
+			name:     "error string",
+			status:   http.StatusNotFound,
+			body:     `{"error":"attributions abc not found"}`,
+			expected: APIError{StatusCode: 404, Message: "attributions abc not found"},
+			message:  "status: 404, message: attributions abc not found",
+		},
+		{
+			name:      "request id header",
+			status:    http.StatusInternalServerError,
+			requestID: "header-id",
+			body:      `{"message":"internal error","requestId":"body-id"}`,
+			expected:  APIError{StatusCode: 500, Message: "internal error", RequestID: "header-id"},
+			message:   "status: 500, message: internal error, request id: header-id",
+		},
+		{
+			name:     "non JSON body",
+			status:   http.StatusBadGateway,
+			body:     "<html>Bad Gateway</html>",
+			expected: APIError{StatusCode: 502},
+			message:  "status: 502, body: <html>Bad Gateway</html>",
+		},
+		{
+			name:     "empty body",
+			status:   http.StatusUnauthorized,
+			expected: APIError{StatusCode: 401},
+			message:  "status: 401, body: ",
+		},
+	}
+	for _, tt := range tests {
+		res := &http.Response{StatusCode: tt.status, Header: http.Header{}}
+		if tt.requestID != "" {
+			res.Header.Set("X-Request-Id", tt.requestID)
+		}
+		apiErr := newAPIError(res, []byte(tt.body))
+		if apiErr.StatusCode != tt.expected.StatusCode || apiErr.Code != tt.expected.Code ||
+			apiErr.Message != tt.expected.Message || apiErr.RequestID != tt.expected.RequestID {
+			t.Errorf("%s: expected %+v, got %+v", tt.name, tt.expected, *apiErr)
+		}
+		if string(apiErr.RawBody) != tt.body {
+			t.Errorf("%s: expected the raw body %q, got %q", tt.name, tt.body, apiErr.RawBody)
+		}
+		if apiErr.Error() != tt.message {
+			t.Errorf("%s: expected the error message %q, got %q", tt.name, tt.message, apiErr.Error())
+		}
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	notFound := &APIError{StatusCode: http.StatusNotFound}
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "nil", err: nil, expected: false},
+		{name: "not found", err: notFound, expected: true},
+		{name: "wrapped not found", err: fmt.Errorf("reading report: %w", notFound), expected: true},
+		{name: "other status", err: &APIError{StatusCode: http.StatusForbidden}, expected: false},
+		{name: "other error", err: errors.New("status: 404"), expected: false},
+	}
+	for _, tt := range tests {
+		if notFound := IsNotFound(tt.err); notFound != tt.expected {
+			t.Errorf("%s: expected IsNotFound %t, got %t", tt.name, tt.expected, notFound)
+		}
+	}
+}
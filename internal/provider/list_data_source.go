@@ -0,0 +1,267 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// listTypes are the types of the objects returned by the list data sources.
+var listTypes = []string{"preset", "custom"}
+
+// listFiltersModel maps the filter attributes shared by the list data sources.
+type listFiltersModel struct {
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	Owner      types.String `tfsdk:"owner"`
+	Type       types.String `tfsdk:"type"`
+	MaxResults types.Int64  `tfsdk:"max_results"`
+}
+
+// listSummaryModel maps an item returned by the list data sources.
+type listSummaryModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Owner       types.String `tfsdk:"owner"`
+	Type        types.String `tfsdk:"type"`
+	CreateTime  types.String `tfsdk:"create_time"`
+	UpdateTime  types.String `tfsdk:"update_time"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &listDataSource[AttributionListItem]{}
+	_ datasource.DataSourceWithConfigure = &listDataSource[AttributionListItem]{}
+)
+
+// NewAttributionsDataSource is a helper function to simplify the provider implementation.
+func NewAttributionsDataSource() datasource.DataSource {
+	return &listDataSource[AttributionListItem]{
+		typeName: "_attributions",
+		title:    "Attributions",
+		kind:     "attribution",
+		itemsKey: "attributions",
+		list:     (*AnalyticsClient).ListAttributions,
+		summary: func(attribution AttributionListItem) listSummaryModel {
+			return listSummaryModel{
+				Id:          types.StringValue(attribution.Id),
+				Name:        types.StringValue(attribution.Name),
+				Description: types.StringValue(attribution.Description),
+				Owner:       types.StringValue(attribution.Owner),
+				Type:        types.StringValue(attribution.Type),
+				CreateTime:  timestampValue(attribution.CreateTime),
+				UpdateTime:  timestampValue(attribution.UpdateTime),
+			}
+		},
+	}
+}
+
+// NewAttributionGroupsDataSource is a helper function to simplify the provider implementation.
+func NewAttributionGroupsDataSource() datasource.DataSource {
+	return &listDataSource[AttributionGroupListItem]{
+		typeName: "_attribution_groups",
+		title:    "Attribution Groups",
+		kind:     "attribution group",
+		itemsKey: "attribution_groups",
+		list:     (*AnalyticsClient).ListAttributionGroups,
+		summary: func(attributionGroup AttributionGroupListItem) listSummaryModel {
+			return listSummaryModel{
+				Id:          types.StringValue(attributionGroup.Id),
+				Name:        types.StringValue(attributionGroup.Name),
+				Description: types.StringValue(attributionGroup.Description),
+				Owner:       types.StringValue(attributionGroup.Owner),
+				Type:        types.StringValue(attributionGroup.Type),
+				CreateTime:  timestampValue(attributionGroup.CreateTime),
+				UpdateTime:  timestampValue(attributionGroup.UpdateTime),
+			}
+		},
+	}
+}
+
+// NewReportsDataSource is a helper function to simplify the provider implementation.
+func NewReportsDataSource() datasource.DataSource {
+	return &listDataSource[ReportListItem]{
+		typeName: "_reports",
+		title:    "Reports",
+		kind:     "report",
+		itemsKey: "reports",
+		list:     (*AnalyticsClient).ListReports,
+		summary: func(report ReportListItem) listSummaryModel {
+			return listSummaryModel{
+				Id:          types.StringValue(report.Id),
+				Name:        types.StringValue(report.ReportName),
+				Description: types.StringValue(report.Description),
+				Owner:       types.StringValue(report.Owner),
+				Type:        types.StringValue(report.Type),
+				CreateTime:  timestampValue(report.CreateTime),
+				UpdateTime:  timestampValue(report.UpdateTime),
+			}
+		},
+	}
+}
+
+// listDataSource is the implementation of the data sources listing the
+// objects of a kind, T being the item type of its list endpoint.
+type listDataSource[T any] struct {
+	client *ClientTest
+
+	// typeName Suffix of the data source type name, e.g. "_attributions"
+	typeName string
+	// kind Name of the listed objects in the descriptions, e.g. "attribution group"
+	kind string
+	// title Name of the listed objects in the error messages, e.g. "Attribution Groups"
+	title string
+	// itemsKey Attribute holding the summaries of the listed objects
+	itemsKey string
+	// list Lists the objects matching opts, following the pagination
+	list func(a *AnalyticsClient, ctx context.Context, opts ListOptions) ([]T, error)
+	// summary Maps a listed object to its summary
+	summary func(item T) listSummaryModel
+}
+
+// Metadata returns the data source type name.
+func (d *listDataSource[T]) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + d.typeName
+}
+
+// Schema defines the schema for the data source.
+func (d *listDataSource[T]) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the " + d.kind + "s of the customer, optionally filtered.",
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				Description: "Only return " + d.kind + "s whose name starts with this prefix",
+				Optional:    true,
+			},
+			"owner": schema.StringAttribute{
+				Description: "Only return " + d.kind + "s owned by this email address",
+				Optional:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Only return " + d.kind + "s of this type. " + oneOfDescription(listTypes),
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(listTypes...),
+				},
+			},
+			"max_results": schema.Int64Attribute{
+				Description: "Number of " + d.kind + "s requested per page. The API default is used when unset.",
+				Optional:    true,
+			},
+			d.itemsKey: schema.ListNestedAttribute{
+				Description: "List of the " + d.kind + "s matching the filters",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Identifier of the " + d.kind,
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the " + d.kind,
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the " + d.kind,
+							Computed:    true,
+						},
+						"owner": schema.StringAttribute{
+							Description: "Email address of the owner of the " + d.kind,
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of the " + d.kind + " (preset or custom)",
+							Computed:    true,
+						},
+						"create_time": schema.StringAttribute{
+							Description: "Creation time of the " + d.kind + " (RFC3339)",
+							Computed:    true,
+						},
+						"update_time": schema.StringAttribute{
+							Description: "Last update time of the " + d.kind + " (RFC3339)",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *listDataSource[T]) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ClientTest)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ClientTest, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data. The filters are
+// kept from the configuration, only the list of summaries is set.
+func (d *listDataSource[T]) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var filters listFiltersModel
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name_prefix"), &filters.NamePrefix)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("owner"), &filters.Owner)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &filters.Type)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("max_results"), &filters.MaxResults)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, err := d.list(d.client.Analytics, ctx, listOptions(filters))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Doit Console "+d.title,
+			"Could not list Doit Console "+d.title+": "+err.Error(),
+		)
+		return
+	}
+
+	summaries := []listSummaryModel{}
+	for _, item := range items {
+		summary := d.summary(item)
+		if !strings.HasPrefix(summary.Name.ValueString(), filters.NamePrefix.ValueString()) {
+			continue
+		}
+		summaries = append(summaries, summary)
+	}
+
+	resp.State.Raw = req.Config.Raw.Copy()
+	diags := resp.State.SetAttribute(ctx, path.Root(d.itemsKey), summaries)
+	resp.Diagnostics.Append(diags...)
+}
+
+// listOptions builds the server side list options from the data source
+// filters. The name prefix is not supported by the API and is applied by
+// the data sources on the listed objects.
+func listOptions(filters listFiltersModel) ListOptions {
+	opts := ListOptions{
+		MaxResults: filters.MaxResults.ValueInt64(),
+	}
+	if filters.Owner.ValueString() != "" {
+		opts.Filters = append(opts.Filters, ListFilter{Key: "owner", Value: filters.Owner.ValueString()})
+	}
+	if filters.Type.ValueString() != "" {
+		opts.Filters = append(opts.Filters, ListFilter{Key: "type", Value: filters.Type.ValueString()})
+	}
+	return opts
+}
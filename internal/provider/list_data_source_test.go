@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-doit-console/internal/fakedoit"
+)
+
+func TestAccListDataSources(t *testing.T) {
+	server := testAccServer(t)
+	for _, attribution := range []map[string]any{
+		{"name": "team-a", "owner": "a@example.com", "type": "custom", "createTime": 1704164645000},
+		{"name": "team-b", "owner": "b@example.com", "type": "custom"},
+		{"name": "team-c", "owner": "a@example.com", "type": "preset"},
+		{"name": "other", "owner": "a@example.com", "type": "custom"},
+	} {
+		server.Put(testAccCustomerContext, fakedoit.Attributions, attribution)
+	}
+	server.Put(testAccCustomerContext, fakedoit.AttributionGroups, map[string]any{
+		"name": "team groups", "description": "grouped", "type": "custom", "attributions": []any{},
+	})
+	server.Put(testAccCustomerContext, fakedoit.Reports, map[string]any{"name": "team report", "type": "custom"})
+	server.Put(testAccCustomerContext, fakedoit.Reports, map[string]any{"name": "other report", "type": "custom"})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+data "doit-console_attributions" "test" {
+  type = "Custom"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Attribute type value must be one of`),
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+# One attribution per page, so that every page is requested.
+data "doit-console_attributions" "all" {
+  max_results = 1
+}
+
+data "doit-console_attributions" "team" {
+  name_prefix = "team-"
+  max_results = 2
+}
+
+data "doit-console_attributions" "filtered" {
+  name_prefix = "team-"
+  owner       = "a@example.com"
+  type        = "custom"
+}
+
+data "doit-console_attribution_groups" "test" {}
+
+data "doit-console_reports" "test" {
+  name_prefix = "team"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.doit-console_attributions.all", "attributions.#", "4"),
+					resource.TestCheckResourceAttr("data.doit-console_attributions.all", "max_results", "1"),
+					resource.TestCheckResourceAttr("data.doit-console_attributions.team", "attributions.#", "3"),
+					resource.TestCheckResourceAttr("data.doit-console_attributions.team", "attributions.0.name", "team-a"),
+					resource.TestCheckResourceAttr("data.doit-console_attributions.team", "attributions.2.name", "team-c"),
+					resource.TestCheckResourceAttr("data.doit-console_attributions.filtered", "attributions.#", "1"),
+					resource.TestCheckResourceAttr("data.doit-console_attributions.filtered", "attributions.0.name", "team-a"),
+					resource.TestCheckResourceAttr("data.doit-console_attributions.filtered", "attributions.0.owner", "a@example.com"),
+					resource.TestCheckResourceAttr("data.doit-console_attributions.filtered", "attributions.0.type", "custom"),
+					resource.TestCheckResourceAttr("data.doit-console_attributions.filtered", "attributions.0.create_time", "2024-01-02T03:04:05Z"),
+					resource.TestCheckResourceAttr("data.doit-console_attribution_groups.test", "attribution_groups.#", "1"),
+					resource.TestCheckResourceAttr("data.doit-console_attribution_groups.test", "attribution_groups.0.name", "team groups"),
+					resource.TestCheckResourceAttr("data.doit-console_attribution_groups.test", "attribution_groups.0.description", "grouped"),
+					resource.TestCheckResourceAttr("data.doit-console_reports.test", "reports.#", "1"),
+					resource.TestCheckResourceAttr("data.doit-console_reports.test", "reports.0.name", "team report"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// apiLogSubsystem is the tflog subsystem of the DoiT API requests. Its level
+// defaults to the provider one and can be changed with the
+// TF_LOG_PROVIDER_DOIT_API environment variable.
+const apiLogSubsystem = "api"
+
+// Fields of the DoiT API request logs.
+const (
+	logFieldMethod         = "http_method"
+	logFieldURL            = "http_url"
+	logFieldStatusCode     = "http_status_code"
+	logFieldDuration       = "http_duration_ms"
+	logFieldRequestHeader  = "http_request_header_"
+	logFieldRequestBody    = "http_request_body"
+	logFieldResponseBody   = "http_response_body"
+	logFieldAuthorization  = logFieldRequestHeader + "authorization"
+	logFieldRequestFailure = "error"
+)
+
+// loggingTransport logs the requests sent to the DoiT API and their responses
+// through tflog, using the logger of the request context. The bodies are only
+// logged at the TRACE level. The Authorization header is masked, as is the API
+// token wherever it appears.
+type loggingTransport struct {
+	transport http.RoundTripper
+	// token is the DoiT API token to mask.
+	token string
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := tflog.NewSubsystem(req.Context(), apiLogSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_DOIT", "API"))
+	ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, apiLogSubsystem, logFieldAuthorization)
+	if t.token != "" {
+		ctx = tflog.SubsystemMaskLogStrings(ctx, apiLogSubsystem, t.token)
+	}
+	ctx = tflog.SubsystemSetField(ctx, apiLogSubsystem, logFieldMethod, req.Method)
+	ctx = tflog.SubsystemSetField(ctx, apiLogSubsystem, logFieldURL, req.URL.String())
+
+	headers := map[string]interface{}{}
+	for name, values := range req.Header {
+		headers[logFieldRequestHeader+strings.ToLower(name)] = strings.Join(values, ", ")
+	}
+	tflog.SubsystemDebug(ctx, apiLogSubsystem, "Sending DoiT API request", headers)
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			content, _ := io.ReadAll(body)
+			body.Close()
+			tflog.SubsystemTrace(ctx, apiLogSubsystem, "DoiT API request body", map[string]interface{}{
+				logFieldRequestBody: string(content),
+			})
+		}
+	}
+
+	start := time.Now()
+	res, err := t.transport.RoundTrip(req)
+	duration := time.Since(start).Milliseconds()
+	if err != nil {
+		tflog.SubsystemDebug(ctx, apiLogSubsystem, "DoiT API request failed", map[string]interface{}{
+			logFieldDuration:       duration,
+			logFieldRequestFailure: err.Error(),
+		})
+		return nil, err
+	}
+	tflog.SubsystemDebug(ctx, apiLogSubsystem, "Received DoiT API response", map[string]interface{}{
+		logFieldStatusCode: res.StatusCode,
+		logFieldDuration:   duration,
+	})
+
+	// Read the body to log it, and hand a copy of it to the caller.
+	content, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(content))
+	tflog.SubsystemTrace(ctx, apiLogSubsystem, "DoiT API response body", map[string]interface{}{
+		logFieldResponseBody: string(content),
+	})
+	return res, nil
+}
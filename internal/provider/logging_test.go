@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+
+	"terraform-provider-doit-console/internal/fakedoit"
+)
+
+func TestLoggingTransport(t *testing.T) {
+	server := fakedoit.New(testAccToken)
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	host, token, customerContext := server.URL, testAccToken, testAccCustomerContext
+	client, err := NewClientTest(ctx, &host, &token, &customerContext, &RetryConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	created, err := client.Analytics.CreateAttribution(ctx, Attribution{
+		Name:       "logged attribution",
+		Components: []Component{{TypeComponent: "fixed", Key: "cloud_provider", Values: []string{"google-cloud"}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Id == "" {
+		t.Fatal("expected the response body to be decoded after being logged")
+	}
+
+	if strings.Contains(output.String(), testAccToken) {
+		t.Errorf("the logs contain the API token:\n%s", output.String())
+	}
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]map[string]any{
+		"Sending DoiT API request": {
+			logFieldMethod:        "POST",
+			logFieldAuthorization: "***",
+		},
+		"DoiT API request body": {
+			logFieldRequestBody: `{"name":"logged attribution","components":[{"type":"fixed","key":"cloud_provider","values":["google-cloud"]}]}`,
+		},
+		"Received DoiT API response": {
+			logFieldMethod:     "POST",
+			logFieldStatusCode: float64(201),
+		},
+	}
+	for _, entry := range entries {
+		fields, ok := expected[entry["@message"].(string)]
+		if !ok || entry[logFieldMethod] != "POST" {
+			continue
+		}
+		for key, value := range fields {
+			if entry[key] != value {
+				t.Errorf("%s: expected %s to be %v, got %v", entry["@message"], key, value, entry[key])
+			}
+		}
+		if !strings.Contains(entry[logFieldURL].(string), "/analytics/v1/attributions") {
+			t.Errorf("%s: unexpected %s %v", entry["@message"], logFieldURL, entry[logFieldURL])
+		}
+		delete(expected, entry["@message"].(string))
+	}
+	for message := range expected {
+		t.Errorf("missing log entry %q", message)
+	}
+}
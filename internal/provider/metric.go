@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+)
+
+// CreateMetric - Create new metric
+func (a *AnalyticsClient) CreateMetric(ctx context.Context, metric Metric) (*Metric, error) {
+	return do[Metric](ctx, a.client, http.MethodPost, analyticsPath("metrics"), nil, metric)
+}
+
+// UpdateMetric - Updates a metric, clearing its description when empty
+func (a *AnalyticsClient) UpdateMetric(ctx context.Context, metricID string, metric Metric) (*Metric, error) {
+	body, err := patchBody(metric, "description")
+	if err != nil {
+		return nil, err
+	}
+	return do[Metric](ctx, a.client, http.MethodPatch, analyticsPath("metrics", metricID), nil, body)
+}
+
+// DeleteMetric - Deletes a metric
+func (a *AnalyticsClient) DeleteMetric(ctx context.Context, metricID string) error {
+	_, err := do[emptyResponse](ctx, a.client, http.MethodDelete, analyticsPath("metrics", metricID), nil, nil)
+	return err
+}
+
+// GetMetric - Returns a specifc metric
+func (a *AnalyticsClient) GetMetric(ctx context.Context, metricID string) (*Metric, error) {
+	return do[Metric](ctx, a.client, http.MethodGet, analyticsPath("metrics", metricID), nil, nil)
+}
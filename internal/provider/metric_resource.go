@@ -0,0 +1,346 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Values accepted by the DoiT API for the enum fields of a metric.
+var (
+	metricFormats         = []string{"currency", "percentage", "number"}
+	metricVariableMetrics = []string{"cost", "usage", "savings"}
+)
+
+// metricResourceModel maps the resource schema data.
+type metricResourceModel struct {
+	Id          types.String          `tfsdk:"id"`
+	Name        types.String          `tfsdk:"name"`
+	Description types.String          `tfsdk:"description"`
+	Formula     types.String          `tfsdk:"formula"`
+	Format      types.String          `tfsdk:"format"`
+	Variables   []metricVariableModel `tfsdk:"variables"`
+	LastUpdated types.String          `tfsdk:"last_updated"`
+	Timeouts    timeouts.Value        `tfsdk:"timeouts"`
+}
+
+// metricVariableModel maps metric variable data.
+type metricVariableModel struct {
+	Metric      types.String `tfsdk:"metric"`
+	Attribution types.String `tfsdk:"attribution"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &metricResource{}
+	_ resource.ResourceWithConfigure   = &metricResource{}
+	_ resource.ResourceWithImportState = &metricResource{}
+)
+
+// NewMetricResource is a helper function to simplify the provider implementation.
+func NewMetricResource() resource.Resource {
+	return &metricResource{}
+}
+
+// metricResource is the resource implementation.
+type metricResource struct {
+	client *ClientTest
+}
+
+// Metadata returns the resource type name.
+func (r *metricResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metric"
+}
+
+// Schema defines the schema for the resource.
+func (r *metricResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Custom calculated metric. Reports can use it by setting a metric " +
+			"of type \"custom\" whose value is the id of this resource.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the metric",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last update of the metric, in RFC 3339 format",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the metric",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the metric",
+				Optional:    true,
+			},
+			"formula": schema.StringAttribute{
+				Description: "Metric formula (A is first variable, B is second " +
+					"variable, etc.) using +, -, *, / and parentheses, e.g. \"A / B\"",
+				Required: true,
+			},
+			"format": schema.StringAttribute{
+				Description: "Format of the metric values. " + oneOfDescription(metricFormats),
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(metricFormats...),
+				},
+			},
+			"variables": schema.ListNestedAttribute{
+				Description: "Variables of the formula",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"metric": schema.StringAttribute{
+							Description: "Basic metric of the variable. " + oneOfDescription(metricVariableMetrics),
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(metricVariableMetrics...),
+							},
+						},
+						"attribution": schema.StringAttribute{
+							Description: "ID of the attribution the metric is computed on",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *metricResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ClientTest)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ClientTest, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// toMetric generates the API request body from the model.
+func (m *metricResourceModel) toMetric() Metric {
+	metric := Metric{
+		Id:          m.Id.ValueString(),
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+		Formula:     m.Formula.ValueString(),
+		Format:      m.Format.ValueString(),
+		Variables:   []MetricVariable{},
+	}
+	for _, variable := range m.Variables {
+		metric.Variables = append(metric.Variables, MetricVariable{
+			Metric:      variable.Metric.ValueString(),
+			Attribution: variable.Attribution.ValueString(),
+		})
+	}
+	return metric
+}
+
+// fromMetric overwrites the model with the metric returned by the API.
+func (m *metricResourceModel) fromMetric(metric *Metric) {
+	if metric.Id != "" {
+		m.Id = types.StringValue(metric.Id)
+	}
+	m.Name = types.StringValue(metric.Name)
+	m.Description = optionalStringValue(m.Description, metric.Description)
+	m.Formula = types.StringValue(metric.Formula)
+	m.Format = types.StringValue(metric.Format)
+	m.Variables = []metricVariableModel{}
+	for _, variable := range metric.Variables {
+		m.Variables = append(m.Variables, metricVariableModel{
+			Metric:      types.StringValue(variable.Metric),
+			Attribution: types.StringValue(variable.Attribution),
+		})
+	}
+	m.LastUpdated = timestampValue(metric.UpdateTime)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *metricResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan metricResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Create new metric
+	metricResponse, err := r.client.Analytics.CreateMetric(ctx, plan.toMetric())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating metric",
+			"Could not create metric, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	plan.Id = types.StringValue(metricResponse.Id)
+	plan.LastUpdated = timestampValue(metricResponse.UpdateTime)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *metricResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state metricResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed metric value from DoiT
+	metric, err := r.client.Analytics.GetMetric(ctx, state.Id.ValueString())
+	if IsNotFound(err) {
+		// The object was deleted outside of Terraform, remove it from
+		// the state so it is planned for creation again.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Doit Console Metric",
+			"Could not read Doit Console Metric ID "+state.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	state.fromMetric(metric)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *metricResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan metricResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state metricResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Update existing metric
+	metric := plan.toMetric()
+	metric.Id = state.Id.ValueString()
+	_, err := r.client.Analytics.UpdateMetric(ctx, state.Id.ValueString(), metric)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating DoiT Metric",
+			"Could not update metric, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Fetch updated items from GetMetric
+	metricResponse, err := r.client.Analytics.GetMetric(ctx, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Doit Console Metric",
+			"Could not read Doit Console metric ID "+state.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update resource state with updated items and timestamp
+	plan.Id = state.Id
+	plan.fromMetric(metricResponse)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ImportState imports an existing metric by its ID.
+func (r *metricResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *metricResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state metricResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing metric
+	err := r.client.Analytics.DeleteMetric(ctx, state.Id.ValueString())
+	if err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting DoiT Metric",
+			"Could not delete metric, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
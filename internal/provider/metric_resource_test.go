@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	"terraform-provider-doit-console/internal/fakedoit"
+)
+
+func TestAccMetricResource(t *testing.T) {
+	server := testAccServer(t)
+	testAccClock(server, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "doit-console_metric", fakedoit.Metrics),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_metric" "test" {
+  name        = "cost per user"
+  description = "test description"
+  formula     = "A / B"
+  format      = "currency"
+  variables = [
+    { metric = "cost", attribution = "attribution-1" },
+    { metric = "usage", attribution = "attribution-2" },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("doit-console_metric.test", "id"),
+					resource.TestCheckResourceAttr("doit-console_metric.test", "last_updated", "2024-01-02T03:04:05Z"),
+					resource.TestCheckResourceAttr("doit-console_metric.test", "name", "cost per user"),
+					resource.TestCheckResourceAttr("doit-console_metric.test", "variables.#", "2"),
+					resource.TestCheckResourceAttr("doit-console_metric.test", "variables.1.metric", "usage"),
+					testAccCheckObject(server, "doit-console_metric.test", fakedoit.Metrics, "formula", "A / B"),
+					testAccCheckObject(server, "doit-console_metric.test", fakedoit.Metrics, "format", "currency"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "doit-console_metric.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_metric" "test" {
+  name        = "savings ratio"
+  description = "updated description"
+  formula     = "A / (A + B)"
+  format      = "percentage"
+  variables = [
+    { metric = "savings", attribution = "attribution-1" },
+    { metric = "cost", attribution = "attribution-1" },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccStoreID("doit-console_metric.test", &id),
+					resource.TestCheckResourceAttr("doit-console_metric.test", "name", "savings ratio"),
+					resource.TestCheckResourceAttr("doit-console_metric.test", "description", "updated description"),
+					resource.TestCheckResourceAttr("doit-console_metric.test", "variables.0.metric", "savings"),
+					testAccCheckObject(server, "doit-console_metric.test", fakedoit.Metrics, "formula", "A / (A + B)"),
+					testAccCheckObject(server, "doit-console_metric.test", fakedoit.Metrics, "format", "percentage"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("doit-console_metric.test", plancheck.ResourceActionUpdate),
+						testAccExpectKnownValue("doit-console_metric.test", "id"),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Recreate after deletion outside of Terraform testing
+			{
+				PreConfig: testAccDeleteOutOfBand(server, fakedoit.Metrics, &id),
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_metric" "test" {
+  name        = "savings ratio"
+  description = "updated description"
+  formula     = "A / (A + B)"
+  format      = "percentage"
+  variables = [
+    { metric = "savings", attribution = "attribution-1" },
+    { metric = "cost", attribution = "attribution-1" },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIDChanged("doit-console_metric.test", &id),
+					testAccCheckObject(server, "doit-console_metric.test", fakedoit.Metrics, "name", "savings ratio"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("doit-console_metric.test", plancheck.ResourceActionCreate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Removing the description clears it
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_metric" "test" {
+  name    = "savings ratio"
+  formula = "A / (A + B)"
+  format  = "percentage"
+  variables = [
+    { metric = "savings", attribution = "attribution-1" },
+    { metric = "cost", attribution = "attribution-1" },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("doit-console_metric.test", "description"),
+					testAccCheckObject(server, "doit-console_metric.test", fakedoit.Metrics, "description", nil),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("doit-console_metric.test", plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccMetricResource_invalidConfig(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_metric" "test" {
+  name      = "cost per user"
+  formula   = "A"
+  format    = "dollars"
+  variables = [{ metric = "cost", attribution = "attribution-1" }]
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Attribute format value must be one of`),
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_metric" "test" {
+  name      = "cost per user"
+  formula   = "A"
+  format    = "number"
+  variables = [{ metric = "Cost", attribution = "attribution-1" }]
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Attribute variables\[0\].metric value must be one of`),
+			},
+		},
+	})
+}
@@ -6,24 +6,90 @@ type Attribution struct {
 	Name        string      `json:"name"`
 	Description string      `json:"description,omitempty"`
 	Formula     string      `json:"formula,omitempty"`
-	LastUpdated string      `json:"last_updated,omitempty"`
 	Components  []Component `json:"components,omitempty"`
+	Owner       string      `json:"owner,omitempty"`
+	// Type Either "preset" or "custom"
+	Type string `json:"type,omitempty"`
+	// CreateTime Creation time in milliseconds since the epoch
+	CreateTime int64 `json:"createTime,omitempty"`
+	// UpdateTime Last update time in milliseconds since the epoch
+	UpdateTime int64 `json:"updateTime,omitempty"`
+}
+
+// AttributionList - Page of attributions returned by the list endpoint
+type AttributionList struct {
+	// PageToken Token to fetch the next page, empty on the last page
+	PageToken    string                `json:"pageToken,omitempty"`
+	RowCount     int64                 `json:"rowCount,omitempty"`
+	Attributions []AttributionListItem `json:"attributions"`
+}
+
+// AttributionListItem - Attribution summary returned by the list endpoint
+type AttributionListItem struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Owner       string `json:"owner,omitempty"`
+	// Type Either "preset" or "custom"
+	Type string `json:"type,omitempty"`
+	// CreateTime Creation time in milliseconds since the epoch
+	CreateTime int64 `json:"createTime,omitempty"`
+	// UpdateTime Last update time in milliseconds since the epoch
+	UpdateTime int64 `json:"updateTime,omitempty"`
 }
 
 // Component -
 type Component struct {
 	TypeComponent string   `json:"type"`
 	Key           string   `json:"key"`
-	Values        []string `json:"values"`
+	Values        []string `json:"values,omitempty"`
+	// Regexp Regular expression matching the values, instead of listing them
+	Regexp string `json:"regexp,omitempty"`
+	// InverseSelection Match the values which are not selected instead
+	InverseSelection bool `json:"inverse_selection,omitempty"`
+	// IncludeNull Also match the resources without a value for the key
+	IncludeNull bool `json:"include_null,omitempty"`
+	// AllowNull Allow null values for the key
+	AllowNull bool `json:"allow_null,omitempty"`
 }
 
 // Attribution -
 type AttributionGroup struct {
-	Id           string   `json:"id,omitempty"`
-	Name         string   `json:"name"`
-	Description  string   `json:"description,omitempty"`
-	LastUpdated  string   `json:"last_updated,omitempty"`
-	Attributions []string `json:"attributions"`
+	Id          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// Attributions IDs of the attributions of the group. Updates leave them
+	// unchanged when nil.
+	Attributions []string `json:"attributions,omitempty"`
+	Owner        string   `json:"owner,omitempty"`
+	// Type Either "preset" or "custom"
+	Type string `json:"type,omitempty"`
+	// CreateTime Creation time in milliseconds since the epoch
+	CreateTime int64 `json:"createTime,omitempty"`
+	// UpdateTime Last update time in milliseconds since the epoch
+	UpdateTime int64 `json:"updateTime,omitempty"`
+}
+
+// AttributionGroupList - Page of attribution groups returned by the list endpoint
+type AttributionGroupList struct {
+	// PageToken Token to fetch the next page, empty on the last page
+	PageToken         string                     `json:"pageToken,omitempty"`
+	RowCount          int64                      `json:"rowCount,omitempty"`
+	AttributionGroups []AttributionGroupListItem `json:"attributionGroups"`
+}
+
+// AttributionGroupListItem - Attribution group summary returned by the list endpoint
+type AttributionGroupListItem struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Owner       string `json:"owner,omitempty"`
+	// Type Either "preset" or "custom"
+	Type string `json:"type,omitempty"`
+	// CreateTime Creation time in milliseconds since the epoch
+	CreateTime int64 `json:"createTime,omitempty"`
+	// UpdateTime Last update time in milliseconds since the epoch
+	UpdateTime int64 `json:"updateTime,omitempty"`
 }
 
 // Attribution -
@@ -31,8 +97,14 @@ type AttributionGroupGet struct {
 	Id           string        `json:"id,omitempty"`
 	Name         string        `json:"name"`
 	Description  string        `json:"description,omitempty"`
-	LastUpdated  string        `json:"last_updated"`
 	Attributions []Attribution `json:"attributions"`
+	Owner        string        `json:"owner,omitempty"`
+	// Type Either "preset" or "custom"
+	Type string `json:"type,omitempty"`
+	// CreateTime Creation time in milliseconds since the epoch
+	CreateTime int64 `json:"createTime,omitempty"`
+	// UpdateTime Last update time in milliseconds since the epoch
+	UpdateTime int64 `json:"updateTime,omitempty"`
 }
 
 // Report defines model for ExternalReport.
@@ -48,6 +120,63 @@ type Report struct {
 
 	// Name Report name
 	Name string `json:"name"`
+
+	// Owner Email address of the owner of the report
+	Owner string `json:"owner,omitempty"`
+
+	// Type Either "preset" or "custom"
+	Type string `json:"type,omitempty"`
+
+	// CreateTime Creation time in milliseconds since the epoch
+	CreateTime int64 `json:"createTime,omitempty"`
+
+	// UpdateTime Last update time in milliseconds since the epoch
+	UpdateTime int64 `json:"updateTime,omitempty"`
+}
+
+// ReportList - Page of reports returned by the list endpoint
+type ReportList struct {
+	// PageToken Token to fetch the next page, empty on the last page
+	PageToken string           `json:"pageToken,omitempty"`
+	RowCount  int64            `json:"rowCount,omitempty"`
+	Reports   []ReportListItem `json:"reports"`
+}
+
+// ReportListItem - Report summary returned by the list endpoint
+type ReportListItem struct {
+	Id          string `json:"id"`
+	ReportName  string `json:"reportName"`
+	Description string `json:"description,omitempty"`
+	Owner       string `json:"owner,omitempty"`
+	// Type Either "preset" or "custom"
+	Type string `json:"type,omitempty"`
+	// CreateTime Creation time in milliseconds since the epoch
+	CreateTime int64 `json:"createTime,omitempty"`
+	// UpdateTime Last update time in milliseconds since the epoch
+	UpdateTime int64 `json:"updateTime,omitempty"`
+}
+
+// ReportSchedule defines model for ExternalReportSchedule.
+//
+// The schedule emails the report to its recipients. A report has at most
+// one schedule.
+type ReportSchedule struct {
+	// Frequency One of "daily", "weekly", "monthly" or "custom"
+	Frequency string `json:"frequency"`
+	// Schedule Cron expression of the delivery, used when Frequency is "custom"
+	Schedule string `json:"schedule,omitempty"`
+	// TimeZone IANA time zone of the schedule, e.g. "Europe/Madrid"
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// Recipients List of emails the report is sent to
+	Recipients []string `json:"recipients"`
+	Subject    string   `json:"subject,omitempty"`
+	Body       string   `json:"body,omitempty"`
+
+	// Format Export format of the report, either "pdf" or "csv"
+	Format string `json:"format,omitempty"`
+	// UpdateTime Last update time in milliseconds since the epoch
+	UpdateTime int64 `json:"updateTime,omitempty"`
 }
 
 // ExternalConfig Report configuration
@@ -212,3 +341,215 @@ type TimeSettings struct {
 	Mode           string `json:"mode,omitempty"`
 	Unit           string `json:"unit,omitempty"`
 }
+
+// Budget defines model for ExternalBudget.
+type Budget struct {
+	// Id Budget id. Leave blank when creating a new budget
+	Id          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+
+	// Alerts List of up to three thresholds defined as percentage of the amount
+	Alerts []BudgetAlert `json:"alerts,omitempty"`
+
+	// Amount Budget amount. Required unless UsePrevSpend is set
+	Amount   float64 `json:"amount,omitempty"`
+	Currency string  `json:"currency,omitempty"`
+
+	// StartPeriod Start of the budget, in milliseconds since the epoch
+	StartPeriod int64 `json:"startPeriod"`
+	// EndPeriod End of a fixed budget, in milliseconds since the epoch
+	EndPeriod int64 `json:"endPeriod,omitempty"`
+
+	// GrowthPerPeriod Periodical growth percentage of a recurring budget
+	GrowthPerPeriod float64 `json:"growthPerPeriod,omitempty"`
+	Metric          string  `json:"metric,omitempty"`
+
+	// Recipients List of emails to notify when reaching alert thresholds
+	Recipients []string `json:"recipients,omitempty"`
+	// RecipientsSlackChannels List of Slack channels to notify when reaching alert thresholds
+	RecipientsSlackChannels []SlackChannel `json:"recipientsSlackChannels,omitempty"`
+
+	// Scope List of attribution IDs the budget applies to
+	Scope []string `json:"scope"`
+
+	// TimeInterval One of "day", "week", "month", "quarter" or "year"
+	TimeInterval string `json:"timeInterval,omitempty"`
+	// Type Either "fixed" or "recurring"
+	Type string `json:"type,omitempty"`
+
+	// UsePrevSpend Use the last period's spend as the target amount of a recurring budget
+	UsePrevSpend bool `json:"usePrevSpend"`
+	// UpdateTime Last update time in milliseconds since the epoch
+	UpdateTime int64 `json:"updateTime,omitempty"`
+}
+
+// BudgetAlert defines model for ExternalBudgetAlert.
+type BudgetAlert struct {
+	// Percentage Percentage of the budget amount triggering the alert
+	Percentage float64 `json:"percentage"`
+}
+
+// SlackChannel defines model for SlackChannel.
+type SlackChannel struct {
+	CustomerId string `json:"customerId,omitempty"`
+	Id         string `json:"id,omitempty"`
+	Name       string `json:"name,omitempty"`
+	Shared     bool   `json:"shared"`
+	Type       string `json:"type,omitempty"`
+	Workspace  string `json:"workspace,omitempty"`
+}
+
+// Metric defines model for ExternalCalculatedMetric.
+//
+// A calculated metric combines basic metrics of attributions, e.g. the
+// formula "A / B" with the variables
+// [{"metric": "cost", "attribution": "<compute>"}, {"metric": "usage", "attribution": "<compute>"}]
+// computes the cost per usage unit of the compute attribution.
+type Metric struct {
+	// Id Metric id. Leave blank when creating a new metric
+	Id          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+
+	// Formula Expression over the variables (A is the first variable, B is
+	// the second variable, etc.) using +, -, *, / and parentheses
+	Formula string `json:"formula"`
+
+	// Format One of "currency", "percentage" or "number"
+	Format string `json:"format,omitempty"`
+
+	// Variables The variables used in the formula
+	Variables []MetricVariable `json:"variables"`
+	// UpdateTime Last update time in milliseconds since the epoch
+	UpdateTime int64 `json:"updateTime,omitempty"`
+}
+
+// MetricVariable defines model for ExternalCalculatedMetricVariable.
+type MetricVariable struct {
+	// Metric Basic metric, one of "cost", "usage" or "savings"
+	Metric string `json:"metric"`
+	// Attribution ID of the attribution the metric is computed on
+	Attribution string `json:"attribution"`
+}
+
+// Alert defines model for ExternalAlert.
+type Alert struct {
+	// Id Alert id. Leave blank when creating a new alert
+	Id     string      `json:"id,omitempty"`
+	Name   string      `json:"name"`
+	Config AlertConfig `json:"config"`
+
+	// Recipients List of emails to notify when the alert is triggered
+	Recipients []string `json:"recipients,omitempty"`
+	// UpdateTime Last update time in milliseconds since the epoch
+	UpdateTime int64 `json:"updateTime,omitempty"`
+}
+
+// AlertConfig Alert configuration
+type AlertConfig struct {
+	Metric *ExternalMetric `json:"metric,omitempty"`
+
+	// Condition One of "value", "percentage-change" or "forecast"
+	Condition string `json:"condition,omitempty"`
+	// Operator Either "gt" or "lt"
+	Operator string `json:"operator"`
+	// Value Threshold of the condition
+	Value float64 `json:"value"`
+	// TimeInterval One of "day", "week", "month", "quarter" or "year"
+	TimeInterval string `json:"timeInterval"`
+	Currency     string `json:"currency,omitempty"`
+
+	// Attributions IDs of the attributions the alert is scoped to
+	Attributions []string `json:"attributions,omitempty"`
+	// Scopes Dimension filters the alert is scoped to
+	Scopes []ExternalConfigFilter `json:"scopes,omitempty"`
+	// EvaluateForEach Dimension to evaluate the condition for each value of
+	EvaluateForEach string `json:"evaluateForEach,omitempty"`
+}
+
+// AnomalyList - Page of anomalies returned by the list endpoint
+type AnomalyList struct {
+	// PageToken Token to fetch the next page, empty on the last page
+	PageToken string    `json:"pageToken,omitempty"`
+	RowCount  int64     `json:"rowCount,omitempty"`
+	Anomalies []Anomaly `json:"anomalies"`
+}
+
+// Anomaly defines model for AnomalyItem.
+type Anomaly struct {
+	Id             string  `json:"id"`
+	Attribution    string  `json:"attribution,omitempty"`
+	BillingAccount string  `json:"billingAccount,omitempty"`
+	CostOfAnomaly  float64 `json:"costOfAnomaly"`
+	Platform       string  `json:"platform,omitempty"`
+	Scope          string  `json:"scope,omitempty"`
+	ServiceName    string  `json:"serviceName,omitempty"`
+	SeverityLevel  string  `json:"severityLevel,omitempty"`
+	// TimeFrame Either "DAILY" or "HOURLY"
+	TimeFrame string `json:"timeFrame,omitempty"`
+	// StartTime Start of the anomaly, in milliseconds since the epoch
+	StartTime int64 `json:"startTime,omitempty"`
+}
+
+// ReportResultResponse defines model for the response of a report run.
+type ReportResultResponse struct {
+	Id         string       `json:"id,omitempty"`
+	ReportName string       `json:"reportName,omitempty"`
+	Result     ReportResult `json:"result"`
+}
+
+// ReportResult defines model for ExternalReportResult.
+type ReportResult struct {
+	// Schema Columns of the rows, in order
+	Schema []ReportResultColumn `json:"schema"`
+	// Rows Values of the rows, one value per column of the schema
+	Rows [][]any `json:"rows"`
+	// ForecastRows Forecasted rows, if forecast is enabled in the report
+	ForecastRows [][]any `json:"forecastRows,omitempty"`
+	CacheHit     bool    `json:"cacheHit"`
+}
+
+// ReportResultColumn defines model for a column of a report result.
+type ReportResultColumn struct {
+	Name string `json:"name"`
+	// Type Type of the column values, such as "string", "float" or "timestamp"
+	Type string `json:"type"`
+}
+
+// ReportQuery defines model for the body of a report query.
+type ReportQuery struct {
+	Config ExternalConfig `json:"config"`
+}
+
+// DimensionList - Page of dimensions returned by the list endpoint
+type DimensionList struct {
+	// PageToken Token to fetch the next page, empty on the last page
+	PageToken  string              `json:"pageToken,omitempty"`
+	RowCount   int64               `json:"rowCount,omitempty"`
+	Dimensions []DimensionListItem `json:"dimensions"`
+}
+
+// DimensionListItem - Dimension summary returned by the list endpoint
+type DimensionListItem struct {
+	// Id Key of the dimension, such as "cloud_provider" or the key of a label
+	Id    string `json:"id"`
+	Label string `json:"label,omitempty"`
+	// Type Type of the dimension, such as "fixed", "label" or "tag"
+	Type string `json:"type"`
+}
+
+// DimensionDetails - Dimension with the values it can take
+type DimensionDetails struct {
+	Id     string           `json:"id"`
+	Label  string           `json:"label,omitempty"`
+	Type   string           `json:"type"`
+	Values []DimensionValue `json:"values"`
+}
+
+// DimensionValue - Value of a dimension
+type DimensionValue struct {
+	Value string `json:"value"`
+	// Cloud Cloud provider the value comes from, such as "google-cloud"
+	Cloud string `json:"cloud,omitempty"`
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"os"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -23,11 +24,24 @@ var (
 // HostURL - Default DoiT URL
 const HostURL string = "https://api.doit.com"
 
+// Default timeouts of the resource operations, used when they are not set in
+// the timeouts block of a resource.
+const (
+	defaultCreateTimeout = 20 * time.Minute
+	defaultReadTimeout   = 5 * time.Minute
+	defaultUpdateTimeout = 20 * time.Minute
+	defaultDeleteTimeout = 5 * time.Minute
+)
+
 // doitProviderModel maps provider schema data to a Go type.
 type doitProviderModel struct {
 	Host            types.String `tfsdk:"host"`
 	DoiTAPITOken    types.String `tfsdk:"api_token"`
 	CustomerContext types.String `tfsdk:"customer_context"`
+	MaxRetries      types.Int64  `tfsdk:"max_retries"`
+	RetryWaitMin    types.Int64  `tfsdk:"retry_wait_min"`
+	RetryWaitMax    types.Int64  `tfsdk:"retry_wait_max"`
+	RetryJitter     types.Bool   `tfsdk:"retry_jitter"`
 }
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -76,6 +90,25 @@ func (p *doitProvider) Schema(ctx context.Context, _ provider.SchemaRequest, res
 					"environment variable. This field is requiered just for DoiT employees ",
 				Optional: true,
 			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Maximum number of retries for requests failing with a transient " +
+					"error (429, 502, 503, 504). Only idempotent requests are retried. Defaults to 3, " +
+					"set to 0 to disable retries.",
+				Optional: true,
+			},
+			"retry_wait_min": schema.Int64Attribute{
+				Description: "Seconds to wait before the first retry. The wait doubles on every " +
+					"following retry. A Retry-After header sent by the API takes precedence. Defaults to 1.",
+				Optional: true,
+			},
+			"retry_wait_max": schema.Int64Attribute{
+				Description: "Maximum number of seconds to wait between retries. Defaults to 30.",
+				Optional:    true,
+			},
+			"retry_jitter": schema.BoolAttribute{
+				Description: "Randomize the wait between retries. Defaults to true.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -191,12 +224,42 @@ func (p *doitProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		)
 	}
 
+	retry := DefaultRetryConfig()
+	if !config.MaxRetries.IsNull() {
+		retry.MaxRetries = int(config.MaxRetries.ValueInt64())
+	}
+	if !config.RetryWaitMin.IsNull() {
+		retry.WaitMin = time.Duration(config.RetryWaitMin.ValueInt64()) * time.Second
+	}
+	if !config.RetryWaitMax.IsNull() {
+		retry.WaitMax = time.Duration(config.RetryWaitMax.ValueInt64()) * time.Second
+	}
+	if !config.RetryJitter.IsNull() {
+		retry.Jitter = config.RetryJitter.ValueBool()
+	}
+
+	if retry.MaxRetries < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_retries"),
+			"Invalid DoiT API Retry Configuration",
+			"max_retries must be greater than or equal to 0.",
+		)
+	}
+
+	if retry.WaitMin < 0 || retry.WaitMax < retry.WaitMin {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_wait_max"),
+			"Invalid DoiT API Retry Configuration",
+			"retry_wait_min must be greater than or equal to 0 and retry_wait_max must be greater than or equal to retry_wait_min.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Create a new DoiT client using the configuration values
-	client, err := NewClientTest(&host, &doiTAPIToken, &customerContext)
+	client, err := NewClientTest(ctx, &host, &doiTAPIToken, &customerContext, &retry)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create DoiT API Client",
@@ -216,8 +279,17 @@ func (p *doitProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 }
 
 // DataSources defines the data sources implemented in the provider.
-func (p *doitProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return nil
+func (p *doitProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	tflog.Debug(ctx, "provider DataSources")
+	return []func() datasource.DataSource{
+		NewAttributionDataSource,
+		NewAttributionsDataSource,
+		NewAttributionGroupsDataSource,
+		NewReportsDataSource,
+		NewAnomaliesDataSource,
+		NewReportResultDataSource,
+		NewDimensionsDataSource,
+	}
 }
 
 // Resources defines the resources implemented in the provider.
@@ -226,6 +298,11 @@ func (p *doitProvider) Resources(ctx context.Context) []func() resource.Resource
 	return []func() resource.Resource{
 		NewAttributionResource,
 		NewAttributionGroupResource,
+		NewAttributionGroupMembershipResource,
 		NewReportResource,
+		NewBudgetResource,
+		NewMetricResource,
+		NewAlertResource,
+		NewReportScheduleResource,
 	}
 }
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"terraform-provider-doit-console/internal/fakedoit"
+)
+
+const (
+	// testAccToken is the API token accepted by the fake DoiT API.
+	testAccToken = "test-token"
+	// testAccCustomerContext is the customer context of the acceptance tests.
+	testAccCustomerContext = "test-customer"
+)
+
+// testAccProtoV6ProviderFactories are used to instantiate a provider during
+// acceptance testing. The factory function will be invoked for every Terraform
+// CLI command executed to create a provider server to which the CLI can
+// reattach.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"doit-console": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+// testAccServer starts a fake DoiT API which is closed at the end of the test.
+func testAccServer(t *testing.T) *fakedoit.Server {
+	server := fakedoit.New(testAccToken)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// testAccClock makes server set the createTime and updateTime of the objects
+// it creates or updates from start, one minute later for every write.
+func testAccClock(server *fakedoit.Server, start time.Time) {
+	next := start
+	server.Now = func() time.Time {
+		now := next
+		next = next.Add(time.Minute)
+		return now
+	}
+}
+
+// testAccProviderConfig returns the provider configuration pointing to server.
+func testAccProviderConfig(server *fakedoit.Server) string {
+	return fmt.Sprintf(`
+provider "doit-console" {
+  host             = %q
+  api_token        = %q
+  customer_context = %q
+}
+`, server.URL, testAccToken, testAccCustomerContext)
+}
+
+// testAccStoreID saves the id of the resource at address to id.
+func testAccStoreID(address string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[address]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", address)
+		}
+		*id = rs.Primary.ID
+		return nil
+	}
+}
+
+// testAccCheckIDChanged checks that the resource at address no longer has id.
+func testAccCheckIDChanged(address string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[address]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", address)
+		}
+		if rs.Primary.ID == *id {
+			return fmt.Errorf("resource %s was not recreated, id is still %s", address, *id)
+		}
+		return nil
+	}
+}
+
+// testAccDeleteOutOfBand deletes the object with id from the fake DoiT API,
+// as if it was deleted in the DoiT console.
+func testAccDeleteOutOfBand(server *fakedoit.Server, collection string, id *string) func() {
+	return func() {
+		server.Delete(testAccCustomerContext, collection, *id)
+	}
+}
+
+// testAccCheckDestroy checks that the objects of the resources of
+// resourceType no longer exist in the fake DoiT API.
+func testAccCheckDestroy(server *fakedoit.Server, resourceType, collection string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+			if _, ok := server.Get(testAccCustomerContext, collection, rs.Primary.ID); ok {
+				return fmt.Errorf("%s %s still exists", resourceType, rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
+
+// testAccCheckObject checks a field of the object of the resource at
+// address, as stored by the fake DoiT API.
+func testAccCheckObject(server *fakedoit.Server, address, collection, field string, expected any) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[address]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", address)
+		}
+		obj, ok := server.Get(testAccCustomerContext, collection, rs.Primary.ID)
+		if !ok {
+			return fmt.Errorf("%s %s not found in the API", collection, rs.Primary.ID)
+		}
+		if actual := fmt.Sprint(obj[field]); actual != fmt.Sprint(expected) {
+			return fmt.Errorf("%s %s: expected %s to be %v, got %s", collection, rs.Primary.ID, field, expected, actual)
+		}
+		return nil
+	}
+}
+
+// testAccExpectKnownValue is a plan check that the attribute of the resource
+// at address is known when planning, e.g. that it keeps its prior state
+// instead of being known only after apply.
+func testAccExpectKnownValue(address, attribute string) plancheck.PlanCheck {
+	return expectKnownValue{address: address, attribute: attribute}
+}
+
+type expectKnownValue struct {
+	address   string
+	attribute string
+}
+
+func (e expectKnownValue) CheckPlan(_ context.Context, req plancheck.CheckPlanRequest, resp *plancheck.CheckPlanResponse) {
+	for _, rc := range req.Plan.ResourceChanges {
+		if rc.Address != e.address {
+			continue
+		}
+		unknown, _ := rc.Change.AfterUnknown.(map[string]any)
+		if value, ok := unknown[e.attribute].(bool); ok && value {
+			resp.Error = fmt.Errorf("%s: attribute %s is unknown, expected a known value", e.address, e.attribute)
+		}
+		return
+	}
+	resp.Error = fmt.Errorf("%s: resource not found in plan", e.address)
+}
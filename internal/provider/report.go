@@ -1,110 +1,70 @@
 package provider
 
 import (
-	"encoding/json"
-	"fmt"
-	"log"
+	"context"
 	"net/http"
-	"strings"
 )
 
 // CreateReport - Create new report
-func (c *ClientTest) CreateReport(report Report) (*Report, error) {
-	rb, err := json.Marshal(report)
-	if err != nil {
-		return nil, err
-	}
-	log.Print("Report body----------------")
-	log.Println(string(rb))
-
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/analytics/v1/reports/?customerContext=%s", c.HostURL, c.Auth.CustomerContext), strings.NewReader(string(rb)))
-	log.Println("URL----------------")
-	log.Println(req.URL)
-	if err != nil {
-		return nil, err
-	}
+func (a *AnalyticsClient) CreateReport(ctx context.Context, report Report) (*Report, error) {
+	return do[Report](ctx, a.client, http.MethodPost, analyticsPath("reports"), nil, report)
+}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		log.Println("ERROR REQUEST----------------")
-		log.Println(err)
-		return nil, err
-	}
+// UpdateReport - Updates an report
+func (a *AnalyticsClient) UpdateReport(ctx context.Context, reportID string, report Report) (*Report, error) {
+	return do[Report](ctx, a.client, http.MethodPatch, analyticsPath("reports", reportID), nil, report)
+}
 
-	reportResponse := Report{}
-	err = json.Unmarshal(body, &reportResponse)
-	if err != nil {
-		log.Println("ERROR UNMARSHALL----------------")
-		log.Println(err)
-		return nil, err
-	}
-	log.Println("Report response----------------")
-	log.Println(reportResponse)
-	return &reportResponse, nil
+// CreateReportSchedule - Create the schedule of a report
+func (a *AnalyticsClient) CreateReportSchedule(ctx context.Context, reportID string, schedule ReportSchedule) (*ReportSchedule, error) {
+	return do[ReportSchedule](ctx, a.client, http.MethodPost, analyticsPath("reports", reportID, "schedule"), nil, schedule)
 }
 
-// UpdateReport - Updates an report
-func (c *ClientTest) UpdateReport(reportID string, report Report) (*Report, error) {
-	rb, err := json.Marshal(report)
-	if err != nil {
-		return nil, err
-	}
-	log.Print("Report body----------------")
-	log.Println(string(rb))
-	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/analytics/v1/reports/%s/?customerContext=%s", c.HostURL, reportID, c.Auth.CustomerContext), strings.NewReader(string(rb)))
-	if err != nil {
-		return nil, err
-	}
-	log.Println("Update URL----------------")
-	log.Println(req.URL)
-	body, err := c.doRequest(req)
+// UpdateReportSchedule - Updates the schedule of a report
+func (a *AnalyticsClient) UpdateReportSchedule(ctx context.Context, reportID string, schedule ReportSchedule) (*ReportSchedule, error) {
+	body, err := patchBody(schedule, "schedule", "timeZone", "subject", "body", "format")
 	if err != nil {
 		return nil, err
 	}
+	return do[ReportSchedule](ctx, a.client, http.MethodPatch, analyticsPath("reports", reportID, "schedule"), nil, body)
+}
 
-	reportResponse := Report{}
-	err = json.Unmarshal(body, &reportResponse)
-	if err != nil {
-		return nil, err
-	}
-	log.Println("Report response----------------")
-	log.Println(reportResponse)
-	return &reportResponse, nil
+// DeleteReportSchedule - Deletes the schedule of a report
+func (a *AnalyticsClient) DeleteReportSchedule(ctx context.Context, reportID string) error {
+	_, err := do[emptyResponse](ctx, a.client, http.MethodDelete, analyticsPath("reports", reportID, "schedule"), nil, nil)
+	return err
 }
 
-func (c *ClientTest) DeleteReport(reportID string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/analytics/v1/reports/%s/?customerContext=%s", c.HostURL, reportID, c.Auth.CustomerContext), nil)
-	if err != nil {
-		return err
-	}
+// GetReportSchedule - Returns the schedule of a report
+func (a *AnalyticsClient) GetReportSchedule(ctx context.Context, reportID string) (*ReportSchedule, error) {
+	return do[ReportSchedule](ctx, a.client, http.MethodGet, analyticsPath("reports", reportID, "schedule"), nil, nil)
+}
 
-	res, err := c.doRequest(req)
-	log.Println(res)
-	if err != nil {
-		return err
-	}
+// DeleteReport - Deletes a report
+func (a *AnalyticsClient) DeleteReport(ctx context.Context, reportID string) error {
+	_, err := do[emptyResponse](ctx, a.client, http.MethodDelete, analyticsPath("reports", reportID), nil, nil)
+	return err
+}
 
-	return nil
+// GetReport - Returns the configuration of a specifc report
+func (a *AnalyticsClient) GetReport(ctx context.Context, reportID string) (*Report, error) {
+	return do[Report](ctx, a.client, http.MethodGet, analyticsPath("reports", reportID, "config"), nil, nil)
 }
 
-// GetReport - Returns a specifc report
-func (c *ClientTest) GetReport(orderID string) (*Report, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/analytics/v1/reports/%s/config?customerContext=%s", c.HostURL, orderID, c.Auth.CustomerContext), nil)
-	if err != nil {
-		return nil, err
-	}
+// ListReports - Returns all the reports matching opts, following the pagination
+func (a *AnalyticsClient) ListReports(ctx context.Context, opts ListOptions) ([]ReportListItem, error) {
+	return listAll(ctx, a.client, analyticsPath("reports"), opts, func(page *ReportList) ([]ReportListItem, string) {
+		return page.Reports, page.PageToken
+	})
+}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-	log.Println("Body----------------")
-	log.Println(string(body))
-	report := Report{}
-	err = json.Unmarshal(body, &report)
-	if err != nil {
-		return nil, err
-	}
+// RunReport - Runs a report and returns its result
+func (a *AnalyticsClient) RunReport(ctx context.Context, reportID string) (*ReportResultResponse, error) {
+	return do[ReportResultResponse](ctx, a.client, http.MethodGet, analyticsPath("reports", reportID), nil, nil)
+}
 
-	return &report, nil
+// QueryReport - Runs a report configuration without saving it and returns its result
+func (a *AnalyticsClient) QueryReport(ctx context.Context, config ExternalConfig) (*ReportResultResponse, error) {
+	// The query doesn't change anything so it can be retried.
+	return do[ReportResultResponse](withRetrySafe(ctx), a.client, http.MethodPost, analyticsPath("reports", "query"), nil, ReportQuery{Config: config})
 }
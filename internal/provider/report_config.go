@@ -0,0 +1,479 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// This file maps the report configuration between its Terraform model and
+// the API. New report fields only need to be added to the models below,
+// toExternalConfig and fromExternalConfig.
+
+// ExternalConfig Report configuration
+type ExternalConfigModel struct {
+	// AdvancedAnalysis Advanced analysis toggles. Each of these can be set independently
+	AdvancedAnalysis *AdvancedAnalysisModel `tfsdk:"advanced_analysis"`
+	Aggregation      types.String           `tfsdk:"aggregation"`
+	Currency         types.String           `tfsdk:"currency"`
+	Dimensions       []DimensionModel       `tfsdk:"dimensions"`
+	DisplayValues    types.String           `tfsdk:"display_values"`
+
+	// Filters The filters to use in this report
+	Filters []ExternalConfigFilterModel `tfsdk:"filters"`
+
+	// Group The groups to use in the report.
+	Group []GroupModel `tfsdk:"group"`
+
+	// IncludePromotionalCredits Whether to include credits or not.
+	// If set, the report must use time interval “month”/”quarter”/”year”
+	IncludePromotionalCredits types.Bool           `tfsdk:"include_promotional_credits"`
+	Layout                    types.String         `tfsdk:"layout"`
+	Metric                    *ExternalMetricModel `tfsdk:"metric"`
+
+	// MetricFilter {
+	// "metric": {
+	// "type":  "basic",
+	// "value": "cost"
+	// },
+	// "operator" : "gt",
+	// "values" : [50]
+	// }
+	MetricFilter *ExternalConfigMetricFilterModel `tfsdk:"metric_filter"`
+
+	// Splits The splits to use in the report.
+	Splits       []ExternalSplitModel `tfsdk:"splits"`
+	TimeInterval types.String         `tfsdk:"time_interval"`
+
+	// TimeRange Time settings for the report
+	// Description: Today is the 17th of April of 2023
+	// We set the mode to "last", the amount to 2 and the unit to "day"
+	// If includeCurrent is not set, the range will be the 15th and 16th of April
+	// If it is, then the range will be 16th and 17th
+	TimeRange *TimeSettingsModel `tfsdk:"time_range"`
+}
+
+// AdvancedAnalysis Advanced analysis toggles. Each of these can be set independently
+type AdvancedAnalysisModel struct {
+	Forecast     types.Bool `tfsdk:"forecast"`
+	NotTrending  types.Bool `tfsdk:"not_trending"`
+	TrendingDown types.Bool `tfsdk:"trending_down"`
+	TrendingUp   types.Bool `tfsdk:"trending_up"`
+}
+
+// GroupModel represents a group in the report.
+type GroupModel struct {
+	Id    types.String `tfsdk:"id"`
+	Type  types.String `tfsdk:"type"`
+	Limit *LimitModel  `tfsdk:"limit"`
+}
+
+type LimitModel struct {
+	Metric *ExternalMetricModel `tfsdk:"metric"`
+	Sort   types.String         `tfsdk:"sort"`
+	// Value The number of items to show
+	Value types.Int64 `tfsdk:"value"`
+}
+
+type ExternalMetricModel struct {
+	Type  types.String `tfsdk:"type"`
+	Value types.String `tfsdk:"value"`
+}
+
+type ExternalConfigMetricFilterModel struct {
+	Metric   *ExternalMetricModel `tfsdk:"metric"`
+	Operator types.String         `tfsdk:"operator"`
+	Values   []types.Float64      `tfsdk:"values"`
+}
+
+// ExternalSplitModel represents a split in the report.
+type ExternalSplitModel struct {
+	// Id ID of the field to split
+	Id types.String `tfsdk:"id"`
+
+	// IncludeOrigin if set, include the origin
+	IncludeOrigin types.Bool           `tfsdk:"include_origin"`
+	Mode          types.String         `tfsdk:"mode"`
+	Origin        *ExternalOriginModel `tfsdk:"origin"`
+
+	// Targets Targets for the split
+	Targets []ExternalSplitTargetModel `tfsdk:"targets"`
+
+	// Type Type of the split.
+	// The only supported value at the moment: "attribution_group"
+	Type types.String `tfsdk:"type"`
+}
+
+// ExternalOrigin defines model for ExternalOrigin.
+type ExternalOriginModel struct {
+	// Id ID of the origin
+	Id types.String `tfsdk:"id"`
+	// Type Type of the origin.
+	// The only supported value at the moment: "attribution"
+	Type types.String `tfsdk:"type"`
+}
+
+// ExternalSplitTarget defines model for ExternalSplitTargetModel.
+type ExternalSplitTargetModel struct {
+	// Id ID of the target
+	Id types.String `tfsdk:"id"`
+	// Type Type of the target.
+	// The only supported value at the moment: "target"
+	Type types.String `tfsdk:"type"`
+	// Value Percent of the target, represented in float format. E.g. 30% is 0.3. Must be set only if Split Mode is custom.
+	Value types.Float64 `tfsdk:"value"`
+}
+
+// TimeSettings Time settings for the report
+// Description: Today is the 17th of April of 2023
+// We set the mode to "last", the amount to 2 and the unit to "day"
+// If includeCurrent is not set, the range will be the 15th and 16th of April
+// If it is, then the range will be 16th and 17th
+type TimeSettingsModel struct {
+	Amount         types.Int64  `tfsdk:"amount"`
+	IncludeCurrent types.Bool   `tfsdk:"include_current"`
+	Mode           types.String `tfsdk:"mode"`
+	Unit           types.String `tfsdk:"unit"`
+}
+
+// Dimension {
+// "id" : "sku_description",
+// "type" : "fixed"
+// }
+type DimensionModel struct {
+	// Id The field to apply to the dimension.
+	Id   types.String `tfsdk:"id"`
+	Type types.String `tfsdk:"type"`
+}
+
+// ExternalConfigFilter {
+// "id" : "sku_description",
+// "type" : "fixed",
+// "values" : ["Nearline Storage Iowa", "Nearline Storage Frankfurt"]
+// }
+//
+// When using attributions as a filter both the type and the ID must be "attribution", and the
+// values array contains the attribution IDs.
+type ExternalConfigFilterModel struct {
+	// Id What field we are filtering on
+	Id types.String `tfsdk:"id"`
+	// Inverse If set, exclude the values
+	Inverse types.Bool   `tfsdk:"inverse"`
+	Type    types.String `tfsdk:"type"`
+	// Values What values to filter on or exclude
+	Values []types.String `tfsdk:"values"`
+}
+
+// toExternalConfig generates the API report configuration from the model.
+// Null and unknown values are sent as the zero value, which the API treats as
+// unset.
+func (m *ExternalConfigModel) toExternalConfig() ExternalConfig {
+	config := ExternalConfig{}
+	if m == nil {
+		return config
+	}
+
+	if m.AdvancedAnalysis != nil {
+		config.AdvancedAnalysis = &AdvancedAnalysis{
+			Forecast:     m.AdvancedAnalysis.Forecast.ValueBool(),
+			NotTrending:  m.AdvancedAnalysis.NotTrending.ValueBool(),
+			TrendingDown: m.AdvancedAnalysis.TrendingDown.ValueBool(),
+			TrendingUp:   m.AdvancedAnalysis.TrendingUp.ValueBool(),
+		}
+	}
+	config.Aggregation = m.Aggregation.ValueString()
+	config.Currency = m.Currency.ValueString()
+	for _, dimension := range m.Dimensions {
+		config.Dimensions = append(config.Dimensions, Dimension{
+			Id:   dimension.Id.ValueString(),
+			Type: dimension.Type.ValueString(),
+		})
+	}
+	config.Filters = externalConfigFilters(m.Filters)
+	config.DisplayValues = m.DisplayValues.ValueString()
+	for _, group := range m.Group {
+		apiGroup := Group{
+			Id:   group.Id.ValueString(),
+			Type: group.Type.ValueString(),
+		}
+		if group.Limit != nil {
+			apiGroup.Limit = &Limit{
+				Metric: group.Limit.Metric.toExternalMetric(),
+				Sort:   group.Limit.Sort.ValueString(),
+				Value:  group.Limit.Value.ValueInt64(),
+			}
+		}
+		config.Group = append(config.Group, apiGroup)
+	}
+	// It needs to be initialized by default because the api return this value
+	// even  if it was not provided when created and the terraform plugin complain
+	// because when creating the resource was null but when read it is not.
+	config.IncludePromotionalCredits = m.IncludePromotionalCredits.ValueBool()
+	config.Layout = m.Layout.ValueString()
+	config.Metric = m.Metric.toExternalMetric()
+	if m.MetricFilter != nil {
+		var values []float64
+		for _, value := range m.MetricFilter.Values {
+			values = append(values, value.ValueFloat64())
+		}
+		config.MetricFilter = &ExternalConfigMetricFilter{
+			Metric:   m.MetricFilter.Metric.toExternalMetric(),
+			Operator: m.MetricFilter.Operator.ValueString(),
+			Values:   values,
+		}
+	}
+	for _, split := range m.Splits {
+		apiSplit := ExternalSplit{
+			Id:            split.Id.ValueString(),
+			IncludeOrigin: split.IncludeOrigin.ValueBool(),
+			Mode:          split.Mode.ValueString(),
+			Type:          split.Type.ValueString(),
+		}
+		if split.Origin != nil {
+			apiSplit.Origin = &ExternalOrigin{
+				Id:   split.Origin.Id.ValueString(),
+				Type: split.Origin.Type.ValueString(),
+			}
+		}
+		for _, target := range split.Targets {
+			apiSplit.Targets = append(apiSplit.Targets, ExternalSplitTarget{
+				Id:    target.Id.ValueString(),
+				Type:  target.Type.ValueString(),
+				Value: target.Value.ValueFloat64(),
+			})
+		}
+		config.Splits = append(config.Splits, apiSplit)
+	}
+	config.TimeInterval = m.TimeInterval.ValueString()
+	if m.TimeRange != nil {
+		config.TimeRange = &TimeSettings{
+			Amount:         m.TimeRange.Amount.ValueInt64(),
+			IncludeCurrent: m.TimeRange.IncludeCurrent.ValueBool(),
+			Mode:           m.TimeRange.Mode.ValueString(),
+			Unit:           m.TimeRange.Unit.ValueString(),
+		}
+	}
+	return config
+}
+
+// fromExternalConfig overwrites the model with the report configuration
+// returned by the API.
+//
+// The API doesn't distinguish unset fields from zero values, so the current
+// values of the model decide how they are stored: an optional attribute or
+// list which is null in the model stays null when the API returns its zero
+// value, and is set otherwise. Elements of lists are matched by index. The
+// bools which the API always returns are stored as is when there is no
+// current value, e.g. after an import.
+func (m *ExternalConfigModel) fromExternalConfig(config ExternalConfig) {
+	if config.AdvancedAnalysis != nil {
+		m.AdvancedAnalysis = &AdvancedAnalysisModel{
+			Forecast:     types.BoolValue(config.AdvancedAnalysis.Forecast),
+			NotTrending:  types.BoolValue(config.AdvancedAnalysis.NotTrending),
+			TrendingDown: types.BoolValue(config.AdvancedAnalysis.TrendingDown),
+			TrendingUp:   types.BoolValue(config.AdvancedAnalysis.TrendingUp),
+		}
+	} else {
+		m.AdvancedAnalysis = nil
+	}
+	m.Aggregation = optionalStringValue(m.Aggregation, config.Aggregation)
+	m.Currency = optionalStringValue(m.Currency, config.Currency)
+
+	dimensions := emptyList(m.Dimensions)
+	for i, dimension := range config.Dimensions {
+		current, _ := listElement(m.Dimensions, i)
+		dimensions = append(dimensions, DimensionModel{
+			Id:   optionalStringValue(current.Id, dimension.Id),
+			Type: optionalStringValue(current.Type, dimension.Type),
+		})
+	}
+	m.Dimensions = dimensions
+
+	m.DisplayValues = optionalStringValue(m.DisplayValues, config.DisplayValues)
+	m.Filters = externalConfigFilterModels(m.Filters, config.Filters)
+
+	groups := emptyList(m.Group)
+	for i, group := range config.Group {
+		current, _ := listElement(m.Group, i)
+		groupModel := GroupModel{
+			Id:   optionalStringValue(current.Id, group.Id),
+			Type: optionalStringValue(current.Type, group.Type),
+		}
+		if group.Limit != nil {
+			currentLimit := LimitModel{}
+			if current.Limit != nil {
+				currentLimit = *current.Limit
+			}
+			groupModel.Limit = &LimitModel{
+				Metric: externalMetricModel(currentLimit.Metric, group.Limit.Metric),
+				Sort:   optionalStringValue(currentLimit.Sort, group.Limit.Sort),
+				Value:  optionalInt64Value(currentLimit.Value, group.Limit.Value),
+			}
+		}
+		groups = append(groups, groupModel)
+	}
+	m.Group = groups
+
+	m.IncludePromotionalCredits = types.BoolValue(config.IncludePromotionalCredits)
+	m.Layout = optionalStringValue(m.Layout, config.Layout)
+	m.Metric = externalMetricModel(m.Metric, config.Metric)
+
+	if config.MetricFilter != nil {
+		current := ExternalConfigMetricFilterModel{}
+		if m.MetricFilter != nil {
+			current = *m.MetricFilter
+		}
+		values := []types.Float64{}
+		for _, value := range config.MetricFilter.Values {
+			values = append(values, types.Float64Value(value))
+		}
+		m.MetricFilter = &ExternalConfigMetricFilterModel{
+			Metric:   externalMetricModel(current.Metric, config.MetricFilter.Metric),
+			Operator: optionalStringValue(current.Operator, config.MetricFilter.Operator),
+			Values:   values,
+		}
+	} else {
+		m.MetricFilter = nil
+	}
+
+	splits := emptyList(m.Splits)
+	for i, split := range config.Splits {
+		current, ok := listElement(m.Splits, i)
+		splitModel := ExternalSplitModel{
+			Id:            optionalStringValue(current.Id, split.Id),
+			IncludeOrigin: returnedBoolValue(current.IncludeOrigin, ok, split.IncludeOrigin),
+			Mode:          optionalStringValue(current.Mode, split.Mode),
+			Type:          optionalStringValue(current.Type, split.Type),
+		}
+		if split.Origin != nil {
+			currentOrigin := ExternalOriginModel{}
+			if current.Origin != nil {
+				currentOrigin = *current.Origin
+			}
+			splitModel.Origin = &ExternalOriginModel{
+				Id:   optionalStringValue(currentOrigin.Id, split.Origin.Id),
+				Type: optionalStringValue(currentOrigin.Type, split.Origin.Type),
+			}
+		}
+		targets := emptyList(current.Targets)
+		for j, target := range split.Targets {
+			currentTarget, _ := listElement(current.Targets, j)
+			targets = append(targets, ExternalSplitTargetModel{
+				Id:    optionalStringValue(currentTarget.Id, target.Id),
+				Type:  optionalStringValue(currentTarget.Type, target.Type),
+				Value: optionalFloat64Value(currentTarget.Value, target.Value),
+			})
+		}
+		splitModel.Targets = targets
+		splits = append(splits, splitModel)
+	}
+	m.Splits = splits
+
+	m.TimeInterval = optionalStringValue(m.TimeInterval, config.TimeInterval)
+	if config.TimeRange != nil {
+		current := TimeSettingsModel{}
+		if m.TimeRange != nil {
+			current = *m.TimeRange
+		}
+		m.TimeRange = &TimeSettingsModel{
+			Amount:         optionalInt64Value(current.Amount, config.TimeRange.Amount),
+			IncludeCurrent: returnedBoolValue(current.IncludeCurrent, m.TimeRange != nil, config.TimeRange.IncludeCurrent),
+			Mode:           optionalStringValue(current.Mode, config.TimeRange.Mode),
+			Unit:           optionalStringValue(current.Unit, config.TimeRange.Unit),
+		}
+	} else {
+		m.TimeRange = nil
+	}
+}
+
+// toExternalMetric converts a metric model into an API metric.
+func (m *ExternalMetricModel) toExternalMetric() *ExternalMetric {
+	if m == nil {
+		return nil
+	}
+	return &ExternalMetric{
+		Type:  m.Type.ValueString(),
+		Value: m.Value.ValueString(),
+	}
+}
+
+// externalMetricModel converts an API metric into its Terraform model,
+// keeping the null fields of current.
+func externalMetricModel(current *ExternalMetricModel, metric *ExternalMetric) *ExternalMetricModel {
+	if metric == nil {
+		return nil
+	}
+	if current == nil {
+		current = &ExternalMetricModel{}
+	}
+	return &ExternalMetricModel{
+		Type:  optionalStringValue(current.Type, metric.Type),
+		Value: optionalStringValue(current.Value, metric.Value),
+	}
+}
+
+// externalConfigFilters converts filter models into API filters.
+func externalConfigFilters(models []ExternalConfigFilterModel) []ExternalConfigFilter {
+	var filters []ExternalConfigFilter
+	for _, model := range models {
+		var values []string
+		for _, value := range model.Values {
+			values = append(values, value.ValueString())
+		}
+		filters = append(filters, ExternalConfigFilter{
+			Id:      model.Id.ValueString(),
+			Inverse: model.Inverse.ValueBool(),
+			Type:    model.Type.ValueString(),
+			Values:  values,
+		})
+	}
+	return filters
+}
+
+// externalConfigFilterModels converts API filters into filter models,
+// keeping the null fields of current.
+func externalConfigFilterModels(current []ExternalConfigFilterModel, filters []ExternalConfigFilter) []ExternalConfigFilterModel {
+	models := emptyList(current)
+	for i, filter := range filters {
+		currentFilter, ok := listElement(current, i)
+		values := []types.String{}
+		for _, value := range filter.Values {
+			values = append(values, types.StringValue(value))
+		}
+		models = append(models, ExternalConfigFilterModel{
+			Id:      optionalStringValue(currentFilter.Id, filter.Id),
+			Inverse: returnedBoolValue(currentFilter.Inverse, ok, filter.Inverse),
+			Type:    optionalStringValue(currentFilter.Type, filter.Type),
+			Values:  values,
+		})
+	}
+	return models
+}
+
+// emptyList returns the list to which the elements returned by the API are
+// appended: nil, which is stored as null, when current is null, and an empty
+// list otherwise.
+func emptyList[T any](current []T) []T {
+	if current == nil {
+		return nil
+	}
+	return []T{}
+}
+
+// listElement returns the element i of current and true, or an element with
+// null attributes and false when there is no such element.
+func listElement[T any](current []T, i int) (T, bool) {
+	var element T
+	if i < len(current) {
+		return current[i], true
+	}
+	return element, false
+}
+
+// returnedBoolValue converts an optional bool which the API always returns,
+// even when false. Without a current value to compare with, e.g. after an
+// import, the returned value is stored as is.
+func returnedBoolValue(current types.Bool, hasCurrent bool, value bool) types.Bool {
+	if !hasCurrent {
+		return types.BoolValue(value)
+	}
+	return optionalBoolValue(current, value)
+}
@@ -0,0 +1,377 @@
+package provider
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+	"testing/quick"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// modelComparer compares the models by the values of their attributes.
+var modelComparer = cmp.Options{
+	cmp.Comparer(func(a, b types.String) bool { return a.Equal(b) }),
+	cmp.Comparer(func(a, b types.Bool) bool { return a.Equal(b) }),
+	cmp.Comparer(func(a, b types.Int64) bool { return a.Equal(b) }),
+	cmp.Comparer(func(a, b types.Float64) bool { return a.Equal(b) }),
+}
+
+// An API configuration converted into a model, as after an import, is sent
+// back to the API unchanged.
+func TestExternalConfigRoundTripFromAPI(t *testing.T) {
+	property := func(seed int64) bool {
+		config := randomExternalConfig(rand.New(rand.NewSource(seed)))
+
+		model := &ExternalConfigModel{}
+		model.fromExternalConfig(config)
+
+		want, _ := json.Marshal(config)
+		got, _ := json.Marshal(model.toExternalConfig())
+		if string(got) != string(want) {
+			t.Logf("seed %d:\nwant %s\ngot  %s", seed, want, got)
+			return false
+		}
+		return true
+	}
+	if err := quick.Check(property, &quick.Config{MaxCount: 500}); err != nil {
+		t.Error(err)
+	}
+}
+
+// A model sent to the API and read back is unchanged, so that no diff is
+// planned after an apply.
+func TestExternalConfigRoundTripFromModel(t *testing.T) {
+	property := func(seed int64) bool {
+		want := randomExternalConfigModel(rand.New(rand.NewSource(seed)))
+		// The same seed generates an identical copy used as current value.
+		got := randomExternalConfigModel(rand.New(rand.NewSource(seed)))
+
+		got.fromExternalConfig(want.toExternalConfig())
+		if diff := cmp.Diff(want, got, modelComparer); diff != "" {
+			t.Logf("seed %d (-want +got):\n%s", seed, diff)
+			return false
+		}
+		return true
+	}
+	if err := quick.Check(property, &quick.Config{MaxCount: 500}); err != nil {
+		t.Error(err)
+	}
+}
+
+// Reading the same API configuration twice doesn't change the model.
+func TestExternalConfigFromAPIIsStable(t *testing.T) {
+	property := func(seed int64) bool {
+		config := randomExternalConfig(rand.New(rand.NewSource(seed)))
+
+		want := &ExternalConfigModel{}
+		want.fromExternalConfig(config)
+		got := &ExternalConfigModel{}
+		got.fromExternalConfig(config)
+		got.fromExternalConfig(config)
+		if diff := cmp.Diff(want, got, modelComparer); diff != "" {
+			t.Logf("seed %d (-want +got):\n%s", seed, diff)
+			return false
+		}
+		return true
+	}
+	if err := quick.Check(property, &quick.Config{MaxCount: 500}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestExternalConfigNullEmptyUnknown(t *testing.T) {
+	model := &ExternalConfigModel{
+		Aggregation:               types.StringUnknown(),
+		Currency:                  types.StringNull(),
+		DisplayValues:             types.StringValue(""),
+		IncludePromotionalCredits: types.BoolValue(false),
+		Dimensions:                []DimensionModel{},
+		Filters:                   nil,
+		Splits: []ExternalSplitModel{{
+			Id:            types.StringValue("split"),
+			IncludeOrigin: types.BoolUnknown(),
+			Targets:       []ExternalSplitTargetModel{{Id: types.StringValue("target"), Value: types.Float64Unknown()}},
+		}},
+	}
+
+	config := model.toExternalConfig()
+	b, err := json.Marshal(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"includePromotionalCredits":false,"splits":[{"id":"split","includeOrigin":false,"targets":[{"id":"target"}]}]}`
+	if string(b) != want {
+		t.Errorf("unexpected API configuration:\nwant %s\ngot  %s", want, b)
+	}
+
+	model.fromExternalConfig(config)
+	if !model.Aggregation.IsNull() {
+		t.Errorf("expected unknown aggregation to be read as null, got %s", model.Aggregation)
+	}
+	if !model.Currency.IsNull() {
+		t.Errorf("expected null currency to stay null, got %s", model.Currency)
+	}
+	if model.DisplayValues.IsNull() || model.DisplayValues.ValueString() != "" {
+		t.Errorf("expected empty display values to stay empty, got %s", model.DisplayValues)
+	}
+	if model.Dimensions == nil || len(model.Dimensions) != 0 {
+		t.Errorf("expected empty dimensions to stay empty, got %v", model.Dimensions)
+	}
+	if model.Filters != nil {
+		t.Errorf("expected null filters to stay null, got %v", model.Filters)
+	}
+	if !model.Splits[0].IncludeOrigin.IsNull() {
+		t.Errorf("expected unknown include_origin to be read as null, got %s", model.Splits[0].IncludeOrigin)
+	}
+	if !model.Splits[0].Targets[0].Value.IsNull() {
+		t.Errorf("expected unknown target value to be read as null, got %s", model.Splits[0].Targets[0].Value)
+	}
+	if model.Metric != nil || model.TimeRange != nil {
+		t.Errorf("expected unset nested objects to stay null, got %v and %v", model.Metric, model.TimeRange)
+	}
+}
+
+func randomString(r *rand.Rand) string {
+	return []string{"", "cost", "fixed", "month", "attribution", "sku_description"}[r.Intn(6)]
+}
+
+func randomStrings(r *rand.Rand) []string {
+	var values []string
+	for i := r.Intn(3); i > 0; i-- {
+		values = append(values, randomString(r))
+	}
+	return values
+}
+
+func randomExternalMetric(r *rand.Rand) *ExternalMetric {
+	if r.Intn(2) == 0 {
+		return nil
+	}
+	return &ExternalMetric{Type: randomString(r), Value: randomString(r)}
+}
+
+// randomExternalConfig generates an API report configuration.
+func randomExternalConfig(r *rand.Rand) ExternalConfig {
+	config := ExternalConfig{
+		Aggregation:               randomString(r),
+		Currency:                  randomString(r),
+		DisplayValues:             randomString(r),
+		IncludePromotionalCredits: r.Intn(2) == 0,
+		Layout:                    randomString(r),
+		Metric:                    randomExternalMetric(r),
+		TimeInterval:              randomString(r),
+	}
+	if r.Intn(2) == 0 {
+		config.AdvancedAnalysis = &AdvancedAnalysis{
+			Forecast:     r.Intn(2) == 0,
+			NotTrending:  r.Intn(2) == 0,
+			TrendingDown: r.Intn(2) == 0,
+			TrendingUp:   r.Intn(2) == 0,
+		}
+	}
+	for i := r.Intn(3); i > 0; i-- {
+		config.Dimensions = append(config.Dimensions, Dimension{Id: randomString(r), Type: randomString(r)})
+	}
+	for i := r.Intn(3); i > 0; i-- {
+		config.Filters = append(config.Filters, ExternalConfigFilter{
+			Id:      randomString(r),
+			Inverse: r.Intn(2) == 0,
+			Type:    randomString(r),
+			Values:  randomStrings(r),
+		})
+	}
+	for i := r.Intn(3); i > 0; i-- {
+		group := Group{Id: randomString(r), Type: randomString(r)}
+		if r.Intn(2) == 0 {
+			group.Limit = &Limit{Metric: randomExternalMetric(r), Sort: randomString(r), Value: r.Int63n(3)}
+		}
+		config.Group = append(config.Group, group)
+	}
+	if r.Intn(2) == 0 {
+		config.MetricFilter = &ExternalConfigMetricFilter{
+			Metric:   randomExternalMetric(r),
+			Operator: randomString(r),
+		}
+		for i := r.Intn(3); i > 0; i-- {
+			config.MetricFilter.Values = append(config.MetricFilter.Values, float64(r.Intn(3))/2)
+		}
+	}
+	for i := r.Intn(3); i > 0; i-- {
+		split := ExternalSplit{
+			Id:            randomString(r),
+			IncludeOrigin: r.Intn(2) == 0,
+			Mode:          randomString(r),
+			Type:          randomString(r),
+		}
+		if r.Intn(2) == 0 {
+			split.Origin = &ExternalOrigin{Id: randomString(r), Type: randomString(r)}
+		}
+		for j := r.Intn(3); j > 0; j-- {
+			split.Targets = append(split.Targets, ExternalSplitTarget{
+				Id:    randomString(r),
+				Type:  randomString(r),
+				Value: float64(r.Intn(3)) / 2,
+			})
+		}
+		config.Splits = append(config.Splits, split)
+	}
+	if r.Intn(2) == 0 {
+		config.TimeRange = &TimeSettings{
+			Amount:         r.Int63n(3),
+			IncludeCurrent: r.Intn(2) == 0,
+			Mode:           randomString(r),
+			Unit:           randomString(r),
+		}
+	}
+	return config
+}
+
+// The random* model generators return null for optional attributes one time
+// out of three.
+
+func randomStringValue(r *rand.Rand) types.String {
+	if r.Intn(3) == 0 {
+		return types.StringNull()
+	}
+	return types.StringValue(randomString(r))
+}
+
+func randomBoolValue(r *rand.Rand) types.Bool {
+	if r.Intn(3) == 0 {
+		return types.BoolNull()
+	}
+	return types.BoolValue(r.Intn(2) == 0)
+}
+
+func randomInt64Value(r *rand.Rand) types.Int64 {
+	if r.Intn(3) == 0 {
+		return types.Int64Null()
+	}
+	return types.Int64Value(r.Int63n(3))
+}
+
+func randomFloat64Value(r *rand.Rand) types.Float64 {
+	if r.Intn(3) == 0 {
+		return types.Float64Null()
+	}
+	return types.Float64Value(float64(r.Intn(3)) / 2)
+}
+
+// randomLength returns -1, for a null list, one time out of three, or a
+// length up to 2.
+func randomLength(r *rand.Rand) int {
+	if r.Intn(3) == 0 {
+		return -1
+	}
+	return r.Intn(3)
+}
+
+func randomExternalMetricModel(r *rand.Rand) *ExternalMetricModel {
+	if r.Intn(2) == 0 {
+		return nil
+	}
+	return &ExternalMetricModel{Type: randomStringValue(r), Value: randomStringValue(r)}
+}
+
+// randomExternalConfigModel generates a report configuration model as
+// planned from a Terraform configuration.
+func randomExternalConfigModel(r *rand.Rand) *ExternalConfigModel {
+	model := &ExternalConfigModel{
+		Aggregation:               randomStringValue(r),
+		Currency:                  randomStringValue(r),
+		DisplayValues:             randomStringValue(r),
+		IncludePromotionalCredits: types.BoolValue(r.Intn(2) == 0),
+		Layout:                    randomStringValue(r),
+		Metric:                    randomExternalMetricModel(r),
+		TimeInterval:              randomStringValue(r),
+	}
+	if r.Intn(2) == 0 {
+		model.AdvancedAnalysis = &AdvancedAnalysisModel{
+			Forecast:     types.BoolValue(r.Intn(2) == 0),
+			NotTrending:  types.BoolValue(r.Intn(2) == 0),
+			TrendingDown: types.BoolValue(r.Intn(2) == 0),
+			TrendingUp:   types.BoolValue(r.Intn(2) == 0),
+		}
+	}
+	if n := randomLength(r); n >= 0 {
+		model.Dimensions = []DimensionModel{}
+		for ; n > 0; n-- {
+			model.Dimensions = append(model.Dimensions, DimensionModel{Id: randomStringValue(r), Type: randomStringValue(r)})
+		}
+	}
+	if n := randomLength(r); n >= 0 {
+		model.Filters = []ExternalConfigFilterModel{}
+		for ; n > 0; n-- {
+			filter := ExternalConfigFilterModel{
+				Id:      randomStringValue(r),
+				Inverse: randomBoolValue(r),
+				Type:    randomStringValue(r),
+				Values:  []types.String{},
+			}
+			for _, value := range randomStrings(r) {
+				filter.Values = append(filter.Values, types.StringValue(value))
+			}
+			model.Filters = append(model.Filters, filter)
+		}
+	}
+	if n := randomLength(r); n >= 0 {
+		model.Group = []GroupModel{}
+		for ; n > 0; n-- {
+			group := GroupModel{Id: randomStringValue(r), Type: randomStringValue(r)}
+			if r.Intn(2) == 0 {
+				group.Limit = &LimitModel{
+					Metric: randomExternalMetricModel(r),
+					Sort:   randomStringValue(r),
+					Value:  randomInt64Value(r),
+				}
+			}
+			model.Group = append(model.Group, group)
+		}
+	}
+	if r.Intn(2) == 0 {
+		model.MetricFilter = &ExternalConfigMetricFilterModel{
+			Metric:   randomExternalMetricModel(r),
+			Operator: randomStringValue(r),
+			Values:   []types.Float64{},
+		}
+		for i := r.Intn(3); i > 0; i-- {
+			model.MetricFilter.Values = append(model.MetricFilter.Values, types.Float64Value(float64(r.Intn(3))/2))
+		}
+	}
+	if n := randomLength(r); n >= 0 {
+		model.Splits = []ExternalSplitModel{}
+		for ; n > 0; n-- {
+			split := ExternalSplitModel{
+				Id:            randomStringValue(r),
+				IncludeOrigin: randomBoolValue(r),
+				Mode:          randomStringValue(r),
+				Type:          randomStringValue(r),
+			}
+			if r.Intn(2) == 0 {
+				split.Origin = &ExternalOriginModel{Id: randomStringValue(r), Type: randomStringValue(r)}
+			}
+			if m := randomLength(r); m >= 0 {
+				split.Targets = []ExternalSplitTargetModel{}
+				for ; m > 0; m-- {
+					split.Targets = append(split.Targets, ExternalSplitTargetModel{
+						Id:    randomStringValue(r),
+						Type:  randomStringValue(r),
+						Value: randomFloat64Value(r),
+					})
+				}
+			}
+			model.Splits = append(model.Splits, split)
+		}
+	}
+	if r.Intn(2) == 0 {
+		model.TimeRange = &TimeSettingsModel{
+			Amount:         randomInt64Value(r),
+			IncludeCurrent: randomBoolValue(r),
+			Mode:           randomStringValue(r),
+			Unit:           randomStringValue(r),
+		}
+	}
+	return model
+}
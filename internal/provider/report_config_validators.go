@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Values accepted by the DoiT API for the enum fields of a report configuration.
+var (
+	reportAggregations = []string{"total", "percent_total", "percent_col", "percent_row"}
+	reportCurrencies   = []string{
+		"USD", "ILS", "EUR", "GBP", "AUD", "CAD", "DKK", "NOK", "SEK", "BRL",
+		"SGD", "MXN", "CHF", "MYR", "TWD", "EGP", "ZAR", "JPY", "IDR",
+	}
+	reportDisplayValues = []string{"actuals_only", "absolute_change", "percentage_change"}
+	reportLayouts       = []string{
+		"table", "table_heatmap", "table_row_heatmap", "table_col_heatmap",
+		"column_chart", "stacked_column_chart", "bar_chart", "stacked_bar_chart",
+		"line_chart", "spline_chart", "area_chart", "area_spline_chart",
+		"stacked_area_chart", "treemap_chart", "csv_export", "sheets_export",
+	}
+	reportTimeIntervals    = []string{"hour", "day", "dayCumSum", "week", "isoweek", "month", "quarter", "year"}
+	reportTimeRangeModes   = []string{"last", "current", "custom"}
+	reportTimeRangeUnits   = []string{"day", "week", "month", "quarter", "year"}
+	reportMetricTypes      = []string{"basic", "custom", "extended"}
+	reportMetricOperators  = []string{"gt", "lt", "lte", "gte", "b", "nb", "e", "ne"}
+	reportGroupLimitSorts  = []string{"a_to_z", "asc", "desc"}
+	reportSplitModes       = []string{"even", "custom", "proportional"}
+	promotionalCreditsTime = []string{"month", "quarter", "year"}
+)
+
+// splitTargetsTolerance is the rounding error allowed in the sum of the
+// custom split target values.
+const splitTargetsTolerance = 1e-9
+
+// oneOfDescription documents the values accepted by an enum attribute.
+func oneOfDescription(values []string) string {
+	return fmt.Sprintf("One of \"%s\".", strings.Join(values, "\", \""))
+}
+
+// The cross-field validators of a report configuration are shared by the
+// report resource and the report_result data source.
+var (
+	_ resource.ConfigValidator   = promotionalCreditsValidator{}
+	_ datasource.ConfigValidator = promotionalCreditsValidator{}
+	_ resource.ConfigValidator   = splitTargetsValidator{}
+	_ datasource.ConfigValidator = splitTargetsValidator{}
+)
+
+// promotionalCreditsValidator checks that a report including promotional
+// credits uses a month, quarter or year time interval.
+type promotionalCreditsValidator struct{}
+
+func (v promotionalCreditsValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("include_promotional_credits requires time_interval to be one of %q", promotionalCreditsTime)
+}
+
+func (v promotionalCreditsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v promotionalCreditsValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(v.validate(ctx, req.Config)...)
+}
+
+func (v promotionalCreditsValidator) ValidateDataSource(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(v.validate(ctx, req.Config)...)
+}
+
+func (v promotionalCreditsValidator) validate(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var credits types.Bool
+	var interval types.String
+	diags := config.GetAttribute(ctx, path.Root("config").AtName("include_promotional_credits"), &credits)
+	diags.Append(config.GetAttribute(ctx, path.Root("config").AtName("time_interval"), &interval)...)
+	if diags.HasError() || !credits.ValueBool() || interval.IsUnknown() {
+		return diags
+	}
+
+	for _, allowed := range promotionalCreditsTime {
+		if interval.ValueString() == allowed {
+			return diags
+		}
+	}
+	diags.AddAttributeError(
+		path.Root("config").AtName("include_promotional_credits"),
+		"Invalid Report Time Interval",
+		fmt.Sprintf("include_promotional_credits can only be set when time_interval is one of %q, got %q.",
+			promotionalCreditsTime, interval.ValueString()),
+	)
+	return diags
+}
+
+// splitTargetsValidator checks that the target values of a custom split sum
+// to 1.
+type splitTargetsValidator struct{}
+
+func (v splitTargetsValidator) Description(_ context.Context) string {
+	return "the target values of a split must sum to 1 when its mode is custom"
+}
+
+func (v splitTargetsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v splitTargetsValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(v.validate(ctx, req.Config)...)
+}
+
+func (v splitTargetsValidator) ValidateDataSource(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(v.validate(ctx, req.Config)...)
+}
+
+func (v splitTargetsValidator) validate(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var splits types.List
+	diags := config.GetAttribute(ctx, path.Root("config").AtName("splits"), &splits)
+	if diags.HasError() || splits.IsNull() || splits.IsUnknown() {
+		return diags
+	}
+
+	for i := range splits.Elements() {
+		splitPath := path.Root("config").AtName("splits").AtListIndex(i)
+		var mode types.String
+		var targets types.List
+		diags.Append(config.GetAttribute(ctx, splitPath.AtName("mode"), &mode)...)
+		diags.Append(config.GetAttribute(ctx, splitPath.AtName("targets"), &targets)...)
+		if diags.HasError() {
+			return diags
+		}
+		if mode.ValueString() != "custom" || targets.IsUnknown() {
+			continue
+		}
+
+		sum, known := 0.0, true
+		for j := range targets.Elements() {
+			var value types.Float64
+			diags.Append(config.GetAttribute(ctx, splitPath.AtName("targets").AtListIndex(j).AtName("value"), &value)...)
+			if diags.HasError() {
+				return diags
+			}
+			if value.IsUnknown() {
+				known = false
+				break
+			}
+			sum += value.ValueFloat64()
+		}
+		if known && math.Abs(sum-1) > splitTargetsTolerance {
+			diags.AddAttributeError(
+				splitPath.AtName("targets"),
+				"Invalid Report Split Targets",
+				fmt.Sprintf("The target values of a split must sum to 1 when mode is custom, got %g.", sum),
+			)
+		}
+	}
+	return diags
+}
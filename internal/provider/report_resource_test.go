@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	"terraform-provider-doit-console/internal/fakedoit"
+)
+
+// testAccReportConfig returns the configuration of a report with the given
+// name and time interval.
+func testAccReportConfig(name, timeInterval string, amount int) string {
+	return `
+resource "doit-console_report" "test" {
+  name        = "` + name + `"
+  description = "test description"
+  config = {
+    metric = {
+      type  = "basic"
+      value = "cost"
+    }
+    include_promotional_credits = false
+    advanced_analysis = {
+      trending_up   = false
+      trending_down = false
+      not_trending  = false
+      forecast      = false
+    }
+    aggregation   = "total"
+    time_interval = "` + timeInterval + `"
+    dimensions = [
+      {
+        id   = "year"
+        type = "datetime"
+      },
+      {
+        id   = "month"
+        type = "datetime"
+      }
+    ]
+    time_range = {
+      mode            = "last"
+      amount          = ` + strconv.Itoa(amount) + `
+      include_current = true
+      unit            = "month"
+    }
+    filters = [
+      {
+        inverse = false
+        id      = "cloud_provider"
+        type    = "fixed"
+        values  = ["google-cloud"]
+      }
+    ]
+    group = [
+      {
+        id   = "cloud_provider"
+        type = "fixed"
+      }
+    ]
+    layout         = "table"
+    display_values = "actuals_only"
+    currency       = "USD"
+  }
+}
+`
+}
+
+func TestAccReportResource(t *testing.T) {
+	server := testAccServer(t)
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "doit-console_report", fakedoit.Reports),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(server) + testAccReportConfig("test report", "month", 12),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("doit-console_report.test", "id"),
+					resource.TestCheckResourceAttrSet("doit-console_report.test", "create_time"),
+					resource.TestCheckResourceAttrPair("doit-console_report.test", "last_updated", "doit-console_report.test", "update_time"),
+					resource.TestCheckResourceAttr("doit-console_report.test", "owner", "owner@example.com"),
+					resource.TestCheckResourceAttr("doit-console_report.test", "type", "custom"),
+					resource.TestCheckResourceAttr("doit-console_report.test", "name", "test report"),
+					resource.TestCheckResourceAttr("doit-console_report.test", "config.metric.value", "cost"),
+					resource.TestCheckResourceAttr("doit-console_report.test", "config.time_interval", "month"),
+					resource.TestCheckResourceAttr("doit-console_report.test", "config.time_range.amount", "12"),
+					resource.TestCheckResourceAttr("doit-console_report.test", "config.dimensions.#", "2"),
+					resource.TestCheckResourceAttr("doit-console_report.test", "config.filters.0.values.0", "google-cloud"),
+					resource.TestCheckResourceAttr("doit-console_report.test", "config.group.0.id", "cloud_provider"),
+					testAccCheckObject(server, "doit-console_report.test", fakedoit.Reports, "name", "test report"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "doit-console_report.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(server) + testAccReportConfig("test report updated", "week", 6),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccStoreID("doit-console_report.test", &id),
+					resource.TestCheckResourceAttr("doit-console_report.test", "name", "test report updated"),
+					resource.TestCheckResourceAttr("doit-console_report.test", "config.time_interval", "week"),
+					resource.TestCheckResourceAttr("doit-console_report.test", "config.time_range.amount", "6"),
+					testAccCheckObject(server, "doit-console_report.test", fakedoit.Reports, "name", "test report updated"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("doit-console_report.test", plancheck.ResourceActionUpdate),
+						testAccExpectKnownValue("doit-console_report.test", "create_time"),
+						testAccExpectKnownValue("doit-console_report.test", "owner"),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Recreate after deletion outside of Terraform testing
+			{
+				PreConfig: testAccDeleteOutOfBand(server, fakedoit.Reports, &id),
+				Config:    testAccProviderConfig(server) + testAccReportConfig("test report updated", "week", 6),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIDChanged("doit-console_report.test", &id),
+					resource.TestCheckResourceAttr("doit-console_report.test", "config.time_range.amount", "6"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("doit-console_report.test", plancheck.ResourceActionCreate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// testAccReportSplitsConfig returns the configuration of a report splitting
+// the cost of its first group with the given mode and target values.
+func testAccReportSplitsConfig(mode string, values ...string) string {
+	var targets []string
+	for i, value := range values {
+		targets = append(targets, `
+          {
+            id    = "target`+strconv.Itoa(i)+`"
+            type  = "attribution"
+            value = `+value+`
+          }`)
+	}
+	splits := `splits = [
+      {
+        id             = "cloud_provider"
+        type           = "fixed"
+        mode           = "` + mode + `"
+        include_origin = true
+        origin = {
+          id   = "origin"
+          type = "attribution"
+        }
+        targets = [` + strings.Join(targets, ",") + `
+        ]
+      }
+    ]
+    layout`
+	return strings.Replace(testAccReportConfig("test report", "month", 12), "layout", splits, 1)
+}
+
+func TestAccReportResource_validation(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(server) + testAccReportConfig("test report", "monthly", 12),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Attribute config.time_interval value must be one of`),
+			},
+			{
+				Config: testAccProviderConfig(server) + strings.Replace(
+					testAccReportConfig("test report", "month", 12), `unit            = "month"`, `unit            = "months"`, 1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Attribute config.time_range.unit value must be one of`),
+			},
+			{
+				Config: testAccProviderConfig(server) + strings.Replace(
+					testAccReportConfig("test report", "week", 12), "include_promotional_credits = false", "include_promotional_credits = true", 1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Report Time Interval`),
+			},
+			{
+				Config:      testAccProviderConfig(server) + testAccReportSplitsConfig("custom", "0.3", "0.6"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Report Split Targets`),
+			},
+			{
+				Config:      testAccProviderConfig(server) + testAccReportSplitsConfig("fair", "0.3", "0.7"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Attribute config.splits\[0\].mode value must be one of`),
+			},
+			{
+				Config:             testAccProviderConfig(server) + testAccReportSplitsConfig("custom", "0.6", "0.3", "0.1"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
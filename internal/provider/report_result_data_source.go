@@ -0,0 +1,341 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// reportResultDataSourceModel maps the data source schema data.
+type reportResultDataSourceModel struct {
+	ReportId types.String              `tfsdk:"report_id"`
+	Config   *ExternalConfigModel      `tfsdk:"config"`
+	Schema   []reportResultColumnModel `tfsdk:"schema"`
+	Rows     []reportResultRowModel    `tfsdk:"rows"`
+}
+
+// reportResultColumnModel maps a column of the result.
+type reportResultColumnModel struct {
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+}
+
+// reportResultRowModel maps a row of the result.
+type reportResultRowModel struct {
+	Values  []types.String  `tfsdk:"values"`
+	Numbers []types.Float64 `tfsdk:"numbers"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                     = &reportResultDataSource{}
+	_ datasource.DataSourceWithConfigure        = &reportResultDataSource{}
+	_ datasource.DataSourceWithValidateConfig   = &reportResultDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &reportResultDataSource{}
+)
+
+// NewReportResultDataSource is a helper function to simplify the provider implementation.
+func NewReportResultDataSource() datasource.DataSource {
+	return &reportResultDataSource{}
+}
+
+// reportResultDataSource is the data source implementation.
+type reportResultDataSource struct {
+	client *ClientTest
+}
+
+// Metadata returns the data source type name.
+func (d *reportResultDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_report_result"
+}
+
+// Schema defines the schema for the data source.
+func (d *reportResultDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	// The config attribute is the one of the report resource, so that any
+	// report configuration can be run as is.
+	var reportSchema resource.SchemaResponse
+	(&reportResource{}).Schema(ctx, resource.SchemaRequest{}, &reportSchema)
+	attribute, err := dataSourceAttribute(reportSchema.Schema.Attributes["config"])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Report Config Schema",
+			"The config attribute of the report resource cannot be converted to a data source attribute: "+
+				err.Error()+". Please report this issue to the provider developers.",
+		)
+		return
+	}
+	config, ok := attribute.(schema.SingleNestedAttribute)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Report Config Schema",
+			"The config attribute of the report resource is not a single nested attribute. "+
+				"Please report this issue to the provider developers.",
+		)
+		return
+	}
+	config.Description = "Report configuration to run. Same as the config of doit-console_report."
+
+	resp.Schema = schema.Schema{
+		Description: "Runs a report and returns its result. Exactly one of report_id or config must be set.",
+		Attributes: map[string]schema.Attribute{
+			"report_id": schema.StringAttribute{
+				Description: "ID of the report to run",
+				Optional:    true,
+			},
+			"config": config,
+			"schema": schema.ListNestedAttribute{
+				Description: "Columns of the result, in order",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the column",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of the column values (string, float, timestamp, etc.)",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"rows": schema.ListNestedAttribute{
+				Description: "Rows of the result",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"values": schema.ListAttribute{
+							Description: "Values of the row formatted as strings, one per column of the schema",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"numbers": schema.ListAttribute{
+							Description: "Numeric values of the row, one per column of the schema. " +
+								"Null for the columns which are not numeric",
+							Computed:    true,
+							ElementType: types.Float64Type,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *reportResultDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ClientTest)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ClientTest, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// ConfigValidators returns the cross-field validations of the report configuration.
+func (d *reportResultDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		promotionalCreditsValidator{},
+		splitTargetsValidator{},
+	}
+}
+
+// ValidateConfig checks that exactly one of report_id or config is set.
+func (d *reportResultDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var reportId types.String
+	diags := req.Config.GetAttribute(ctx, path.Root("report_id"), &reportId)
+	resp.Diagnostics.Append(diags...)
+	var config types.Object
+	diags = req.Config.GetAttribute(ctx, path.Root("config"), &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if reportId.IsUnknown() || config.IsUnknown() {
+		return
+	}
+
+	if reportId.IsNull() == config.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("report_id"),
+			"Invalid Report Result Configuration",
+			"Exactly one of report_id or config must be set to run a report.",
+		)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *reportResultDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state reportResultDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result *ReportResultResponse
+	var err error
+	if !state.ReportId.IsNull() {
+		result, err = d.client.Analytics.RunReport(ctx, state.ReportId.ValueString())
+	} else {
+		result, err = d.client.Analytics.QueryReport(ctx, state.Config.toExternalConfig())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Running Doit Console Report",
+			"Could not run Doit Console Report: "+err.Error(),
+		)
+		return
+	}
+
+	state.Schema = []reportResultColumnModel{}
+	for _, column := range result.Result.Schema {
+		state.Schema = append(state.Schema, reportResultColumnModel{
+			Name: types.StringValue(column.Name),
+			Type: types.StringValue(column.Type),
+		})
+	}
+
+	state.Rows = []reportResultRowModel{}
+	for _, row := range result.Result.Rows {
+		rowModel := reportResultRowModel{
+			Values:  []types.String{},
+			Numbers: []types.Float64{},
+		}
+		for _, value := range row {
+			switch v := value.(type) {
+			case nil:
+				rowModel.Values = append(rowModel.Values, types.StringNull())
+				rowModel.Numbers = append(rowModel.Numbers, types.Float64Null())
+			case float64:
+				rowModel.Values = append(rowModel.Values, types.StringValue(strconv.FormatFloat(v, 'f', -1, 64)))
+				rowModel.Numbers = append(rowModel.Numbers, types.Float64Value(v))
+			default:
+				rowModel.Values = append(rowModel.Values, types.StringValue(fmt.Sprintf("%v", v)))
+				rowModel.Numbers = append(rowModel.Numbers, types.Float64Null())
+			}
+		}
+		state.Rows = append(state.Rows, rowModel)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// dataSourceAttribute converts a resource schema attribute into the
+// equivalent data source schema attribute. Plan modifiers and defaults have
+// no data source counterpart and are dropped. The attribute types which no
+// report attribute uses are not supported and return an error.
+func dataSourceAttribute(attribute resourceschema.Attribute) (schema.Attribute, error) {
+	switch a := attribute.(type) {
+	case resourceschema.StringAttribute:
+		return schema.StringAttribute{
+			Description: a.Description,
+			Required:    a.Required,
+			Optional:    a.Optional,
+			Computed:    a.Computed,
+			Sensitive:   a.Sensitive,
+			Validators:  a.Validators,
+		}, nil
+	case resourceschema.BoolAttribute:
+		return schema.BoolAttribute{
+			Description: a.Description,
+			Required:    a.Required,
+			Optional:    a.Optional,
+			Computed:    a.Computed,
+			Sensitive:   a.Sensitive,
+			Validators:  a.Validators,
+		}, nil
+	case resourceschema.Int64Attribute:
+		return schema.Int64Attribute{
+			Description: a.Description,
+			Required:    a.Required,
+			Optional:    a.Optional,
+			Computed:    a.Computed,
+			Sensitive:   a.Sensitive,
+			Validators:  a.Validators,
+		}, nil
+	case resourceschema.Float64Attribute:
+		return schema.Float64Attribute{
+			Description: a.Description,
+			Required:    a.Required,
+			Optional:    a.Optional,
+			Computed:    a.Computed,
+			Sensitive:   a.Sensitive,
+			Validators:  a.Validators,
+		}, nil
+	case resourceschema.ListAttribute:
+		return schema.ListAttribute{
+			Description: a.Description,
+			ElementType: a.ElementType,
+			Required:    a.Required,
+			Optional:    a.Optional,
+			Computed:    a.Computed,
+			Sensitive:   a.Sensitive,
+			Validators:  a.Validators,
+		}, nil
+	case resourceschema.SingleNestedAttribute:
+		attributes, err := dataSourceAttributes(a.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		return schema.SingleNestedAttribute{
+			Description: a.Description,
+			Attributes:  attributes,
+			Required:    a.Required,
+			Optional:    a.Optional,
+			Computed:    a.Computed,
+			Sensitive:   a.Sensitive,
+			Validators:  a.Validators,
+		}, nil
+	case resourceschema.ListNestedAttribute:
+		attributes, err := dataSourceAttributes(a.NestedObject.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		return schema.ListNestedAttribute{
+			Description: a.Description,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: attributes,
+				Validators: a.NestedObject.Validators,
+			},
+			Required:   a.Required,
+			Optional:   a.Optional,
+			Computed:   a.Computed,
+			Sensitive:  a.Sensitive,
+			Validators: a.Validators,
+		}, nil
+	}
+	return nil, fmt.Errorf("unsupported resource schema attribute type %T", attribute)
+}
+
+// dataSourceAttributes converts a map of resource schema attributes.
+func dataSourceAttributes(attributes map[string]resourceschema.Attribute) (map[string]schema.Attribute, error) {
+	converted := make(map[string]schema.Attribute, len(attributes))
+	for name, attribute := range attributes {
+		dataSourceAttribute, err := dataSourceAttribute(attribute)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		converted[name] = dataSourceAttribute
+	}
+	return converted, nil
+}
@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"terraform-provider-doit-console/internal/fakedoit"
+)
+
+func TestDataSourceAttribute(t *testing.T) {
+	_, err := dataSourceAttribute(resourceschema.SingleNestedAttribute{
+		Attributes: map[string]resourceschema.Attribute{
+			"labels": resourceschema.MapAttribute{ElementType: types.StringType, Optional: true},
+		},
+		Optional: true,
+	})
+	if err == nil || !strings.Contains(err.Error(), "labels: unsupported resource schema attribute type schema.MapAttribute") {
+		t.Errorf("expected an unsupported attribute type error, got %v", err)
+	}
+
+	var resp datasource.SchemaResponse
+	(&reportResultDataSource{}).Schema(context.Background(), datasource.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("expected the report config to be converted, got %v", resp.Diagnostics)
+	}
+}
+
+func TestAccReportResultDataSource(t *testing.T) {
+	server := testAccServer(t)
+	reportID := server.Put(testAccCustomerContext, fakedoit.Reports, map[string]any{
+		"name": "monthly cost",
+		"result": map[string]any{
+			"schema": []any{
+				map[string]any{"name": "service", "type": "string"},
+				map[string]any{"name": "cost", "type": "float"},
+				map[string]any{"name": "forecast", "type": "float"},
+			},
+			"rows": []any{
+				[]any{"Compute Engine", 1234.5, nil},
+				[]any{"Cloud Storage", 0.25, 1e21},
+			},
+		},
+	})
+	server.QueryResult = map[string]any{
+		"schema": []any{map[string]any{"name": "year", "type": "string"}, map[string]any{"name": "cost", "type": "float"}},
+		"rows":   []any{[]any{"2024", 42}},
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+data "doit-console_report_result" "test" {}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Exactly one of report_id or config must be set`),
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+data "doit-console_report_result" "test" {
+  report_id = "` + reportID + `"
+  config = {
+    include_promotional_credits = false
+    advanced_analysis = {
+      trending_up   = false
+      trending_down = false
+      not_trending  = false
+      forecast      = false
+    }
+  }
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Exactly one of report_id or config must be set`),
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+data "doit-console_report_result" "test" {
+  report_id = "missing"
+}
+`,
+				ExpectError: regexp.MustCompile(`Could not run Doit Console Report`),
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+data "doit-console_report_result" "by_id" {
+  report_id = "` + reportID + `"
+}
+
+data "doit-console_report_result" "by_config" {
+  config = {
+    include_promotional_credits = false
+    advanced_analysis = {
+      trending_up   = false
+      trending_down = false
+      not_trending  = false
+      forecast      = false
+    }
+    time_interval = "year"
+    dimensions    = [{ id = "year", type = "datetime" }]
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.doit-console_report_result.by_id", "schema.#", "3"),
+					resource.TestCheckResourceAttr("data.doit-console_report_result.by_id", "schema.1.name", "cost"),
+					resource.TestCheckResourceAttr("data.doit-console_report_result.by_id", "schema.1.type", "float"),
+					resource.TestCheckResourceAttr("data.doit-console_report_result.by_id", "rows.#", "2"),
+					// Strings are kept as is and have no numeric value.
+					resource.TestCheckResourceAttr("data.doit-console_report_result.by_id", "rows.0.values.0", "Compute Engine"),
+					resource.TestCheckNoResourceAttr("data.doit-console_report_result.by_id", "rows.0.numbers.0"),
+					// Numbers are formatted without exponent.
+					resource.TestCheckResourceAttr("data.doit-console_report_result.by_id", "rows.0.values.1", "1234.5"),
+					resource.TestCheckResourceAttr("data.doit-console_report_result.by_id", "rows.0.numbers.1", "1234.5"),
+					resource.TestCheckResourceAttr("data.doit-console_report_result.by_id", "rows.1.values.2", "1000000000000000000000"),
+					// Nulls are null in both lists.
+					resource.TestCheckResourceAttr("data.doit-console_report_result.by_id", "rows.0.values.#", "3"),
+					resource.TestCheckNoResourceAttr("data.doit-console_report_result.by_id", "rows.0.values.2"),
+					resource.TestCheckNoResourceAttr("data.doit-console_report_result.by_id", "rows.0.numbers.2"),
+					resource.TestCheckResourceAttr("data.doit-console_report_result.by_config", "schema.0.name", "year"),
+					resource.TestCheckResourceAttr("data.doit-console_report_result.by_config", "rows.#", "1"),
+					resource.TestCheckResourceAttr("data.doit-console_report_result.by_config", "rows.0.values.0", "2024"),
+					resource.TestCheckResourceAttr("data.doit-console_report_result.by_config", "rows.0.numbers.1", "42"),
+					testAccCheckRequested(server, http.MethodPost, "/analytics/v1/reports/query"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckRequested checks that the server received a successful
+// request with the given method and path.
+func testAccCheckRequested(server *fakedoit.Server, method, path string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		for _, request := range server.Requests() {
+			if request.Method == method && request.Path == path && request.Status == http.StatusOK {
+				return nil
+			}
+		}
+		return fmt.Errorf("expected a successful %s %s request", method, path)
+	}
+}
@@ -0,0 +1,354 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Values accepted by the DoiT API for the enum fields of a report schedule.
+var (
+	reportScheduleFrequencies = []string{"daily", "weekly", "monthly", "custom"}
+	reportScheduleFormats     = []string{"pdf", "csv"}
+)
+
+// reportScheduleResourceModel maps the resource schema data.
+type reportScheduleResourceModel struct {
+	Id          types.String   `tfsdk:"id"`
+	ReportId    types.String   `tfsdk:"report_id"`
+	Frequency   types.String   `tfsdk:"frequency"`
+	Schedule    types.String   `tfsdk:"schedule"`
+	TimeZone    types.String   `tfsdk:"time_zone"`
+	Recipients  []types.String `tfsdk:"recipients"`
+	Subject     types.String   `tfsdk:"subject"`
+	Body        types.String   `tfsdk:"body"`
+	Format      types.String   `tfsdk:"format"`
+	LastUpdated types.String   `tfsdk:"last_updated"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &reportScheduleResource{}
+	_ resource.ResourceWithConfigure      = &reportScheduleResource{}
+	_ resource.ResourceWithImportState    = &reportScheduleResource{}
+	_ resource.ResourceWithValidateConfig = &reportScheduleResource{}
+)
+
+// NewReportScheduleResource is a helper function to simplify the provider implementation.
+func NewReportScheduleResource() resource.Resource {
+	return &reportScheduleResource{}
+}
+
+// reportScheduleResource is the resource implementation.
+type reportScheduleResource struct {
+	client *ClientTest
+}
+
+// Metadata returns the resource type name.
+func (r *reportScheduleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_report_schedule"
+}
+
+// Schema defines the schema for the resource.
+func (r *reportScheduleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Email delivery schedule of a report. A report has at most one schedule.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the schedule, same as report_id",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last update of the report schedule, in RFC 3339 format",
+				Computed:    true,
+			},
+			"report_id": schema.StringAttribute{
+				Description: "ID of the scheduled report",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"frequency": schema.StringAttribute{
+				Description: "Delivery frequency. " + oneOfDescription(reportScheduleFrequencies),
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(reportScheduleFrequencies...),
+				},
+			},
+			"schedule": schema.StringAttribute{
+				Description: "Cron expression of the delivery, e.g. \"0 8 * * 1\". " +
+					"Required when frequency is custom",
+				Optional: true,
+			},
+			"time_zone": schema.StringAttribute{
+				Description: "IANA time zone of the schedule, e.g. \"Europe/Madrid\"",
+				Optional:    true,
+			},
+			"recipients": schema.ListAttribute{
+				Description: "List of emails the report is sent to",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"subject": schema.StringAttribute{
+				Description: "Subject of the email",
+				Optional:    true,
+			},
+			"body": schema.StringAttribute{
+				Description: "Body of the email",
+				Optional:    true,
+			},
+			"format": schema.StringAttribute{
+				Description: "Export format of the report. " + oneOfDescription(reportScheduleFormats),
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(reportScheduleFormats...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *reportScheduleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ClientTest)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ClientTest, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks that a cron schedule is set if and only if the
+// frequency is custom.
+func (r *reportScheduleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config reportScheduleResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Frequency.IsUnknown() || config.Frequency.IsNull() || config.Schedule.IsUnknown() {
+		return
+	}
+
+	custom := config.Frequency.ValueString() == "custom"
+	if custom && config.Schedule.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("schedule"),
+			"Missing Report Schedule",
+			"schedule must be set when frequency is custom.",
+		)
+	}
+	if !custom && !config.Schedule.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("schedule"),
+			"Unexpected Report Schedule",
+			"schedule can only be set when frequency is custom.",
+		)
+	}
+}
+
+// toReportSchedule generates the API request body from the model.
+func (m *reportScheduleResourceModel) toReportSchedule() ReportSchedule {
+	schedule := ReportSchedule{
+		Frequency:  m.Frequency.ValueString(),
+		Schedule:   m.Schedule.ValueString(),
+		TimeZone:   m.TimeZone.ValueString(),
+		Recipients: []string{},
+		Subject:    m.Subject.ValueString(),
+		Body:       m.Body.ValueString(),
+		Format:     m.Format.ValueString(),
+	}
+	for _, recipient := range m.Recipients {
+		schedule.Recipients = append(schedule.Recipients, recipient.ValueString())
+	}
+	return schedule
+}
+
+// fromReportSchedule overwrites the model with the schedule returned by the API.
+func (m *reportScheduleResourceModel) fromReportSchedule(schedule *ReportSchedule) {
+	m.Frequency = types.StringValue(schedule.Frequency)
+	m.Schedule = optionalStringValue(m.Schedule, schedule.Schedule)
+	m.TimeZone = optionalStringValue(m.TimeZone, schedule.TimeZone)
+	m.Subject = optionalStringValue(m.Subject, schedule.Subject)
+	m.Body = optionalStringValue(m.Body, schedule.Body)
+	m.Format = optionalStringValue(m.Format, schedule.Format)
+	m.Recipients = []types.String{}
+	for _, recipient := range schedule.Recipients {
+		m.Recipients = append(m.Recipients, types.StringValue(recipient))
+	}
+	m.LastUpdated = timestampValue(schedule.UpdateTime)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *reportScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan reportScheduleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Create new report schedule
+	scheduleResponse, err := r.client.Analytics.CreateReportSchedule(ctx, plan.ReportId.ValueString(), plan.toReportSchedule())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating report schedule",
+			"Could not create report schedule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	plan.Id = plan.ReportId
+	plan.LastUpdated = timestampValue(scheduleResponse.UpdateTime)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *reportScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state reportScheduleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed report schedule value from DoiT
+	schedule, err := r.client.Analytics.GetReportSchedule(ctx, state.Id.ValueString())
+	if IsNotFound(err) {
+		// The schedule or its report was deleted outside of Terraform,
+		// remove it from the state so it is planned for creation again.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Doit Console Report Schedule",
+			"Could not read Doit Console Report Schedule of report ID "+state.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	state.ReportId = state.Id
+	state.fromReportSchedule(schedule)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *reportScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan reportScheduleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Update existing report schedule
+	scheduleResponse, err := r.client.Analytics.UpdateReportSchedule(ctx, plan.ReportId.ValueString(), plan.toReportSchedule())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating DoiT Report Schedule",
+			"Could not update report schedule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Update resource state with updated items and timestamp
+	plan.Id = plan.ReportId
+	plan.fromReportSchedule(scheduleResponse)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ImportState imports the schedule of an existing report by the report ID.
+func (r *reportScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *reportScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state reportScheduleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing report schedule
+	err := r.client.Analytics.DeleteReportSchedule(ctx, state.Id.ValueString())
+	if err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting DoiT Report Schedule",
+			"Could not delete report schedule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	"terraform-provider-doit-console/internal/fakedoit"
+)
+
+// testAccReportScheduleConfig returns the configuration of the schedule of
+// reportID with the given frequency and recipient.
+func testAccReportScheduleConfig(reportID, frequency, recipient string) string {
+	return `
+resource "doit-console_report_schedule" "test" {
+  report_id  = "` + reportID + `"
+  frequency  = "` + frequency + `"
+  time_zone  = "Europe/Madrid"
+  recipients = ["` + recipient + `"]
+  subject    = "Weekly costs"
+  format     = "pdf"
+}
+`
+}
+
+func TestAccReportScheduleResource(t *testing.T) {
+	server := testAccServer(t)
+	testAccClock(server, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+	reportID := server.Put(testAccCustomerContext, fakedoit.Reports, map[string]any{"name": "weekly costs"})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "doit-console_report_schedule", fakedoit.ReportSchedules),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(server) + testAccReportScheduleConfig(reportID, "weekly", "finops@example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("doit-console_report_schedule.test", "id", reportID),
+					resource.TestCheckResourceAttr("doit-console_report_schedule.test", "last_updated", "2024-01-02T03:04:05Z"),
+					resource.TestCheckResourceAttr("doit-console_report_schedule.test", "frequency", "weekly"),
+					resource.TestCheckResourceAttr("doit-console_report_schedule.test", "recipients.0", "finops@example.com"),
+					resource.TestCheckNoResourceAttr("doit-console_report_schedule.test", "schedule"),
+					testAccCheckObject(server, "doit-console_report_schedule.test", fakedoit.ReportSchedules, "timeZone", "Europe/Madrid"),
+					testAccCheckObject(server, "doit-console_report_schedule.test", fakedoit.ReportSchedules, "subject", "Weekly costs"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "doit-console_report_schedule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_report_schedule" "test" {
+  report_id  = "` + reportID + `"
+  frequency  = "custom"
+  schedule   = "0 8 * * 1"
+  time_zone  = "Europe/Madrid"
+  recipients = ["finops@example.com", "cto@example.com"]
+  subject    = "Weekly costs"
+  format     = "csv"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("doit-console_report_schedule.test", "id", reportID),
+					resource.TestCheckResourceAttr("doit-console_report_schedule.test", "frequency", "custom"),
+					resource.TestCheckResourceAttr("doit-console_report_schedule.test", "schedule", "0 8 * * 1"),
+					resource.TestCheckResourceAttr("doit-console_report_schedule.test", "recipients.#", "2"),
+					testAccCheckObject(server, "doit-console_report_schedule.test", fakedoit.ReportSchedules, "format", "csv"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("doit-console_report_schedule.test", plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Recreate after deletion outside of Terraform testing. The
+			// schedule keeps the id of its report.
+			{
+				PreConfig: testAccDeleteOutOfBand(server, fakedoit.ReportSchedules, &reportID),
+				Config:    testAccProviderConfig(server) + testAccReportScheduleConfig(reportID, "monthly", "finops@example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("doit-console_report_schedule.test", "id", reportID),
+					testAccCheckObject(server, "doit-console_report_schedule.test", fakedoit.ReportSchedules, "frequency", "monthly"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("doit-console_report_schedule.test", plancheck.ResourceActionCreate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Removing the optional attributes clears them
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_report_schedule" "test" {
+  report_id  = "` + reportID + `"
+  frequency  = "monthly"
+  recipients = ["finops@example.com"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("doit-console_report_schedule.test", "subject"),
+					resource.TestCheckNoResourceAttr("doit-console_report_schedule.test", "time_zone"),
+					resource.TestCheckNoResourceAttr("doit-console_report_schedule.test", "format"),
+					testAccCheckObject(server, "doit-console_report_schedule.test", fakedoit.ReportSchedules, "subject", nil),
+					testAccCheckObject(server, "doit-console_report_schedule.test", fakedoit.ReportSchedules, "format", nil),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("doit-console_report_schedule.test", plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccReportScheduleResource_invalidConfig(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_report_schedule" "test" {
+  report_id  = "report"
+  frequency  = "custom"
+  recipients = ["finops@example.com"]
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`schedule must be set when frequency is custom`),
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_report_schedule" "test" {
+  report_id  = "report"
+  frequency  = "weekly"
+  schedule   = "0 8 * * 1"
+  recipients = ["finops@example.com"]
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`schedule can only be set when frequency is custom`),
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_report_schedule" "test" {
+  report_id  = "report"
+  frequency  = "hourly"
+  recipients = ["finops@example.com"]
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Attribute frequency value must be one of`),
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+resource "doit-console_report_schedule" "test" {
+  report_id  = "report"
+  frequency  = "weekly"
+  recipients = ["finops@example.com"]
+  format     = "xlsx"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Attribute format value must be one of`),
+			},
+		},
+	})
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// optionalStringValue converts a string returned by the API into the value
+// stored for an optional attribute. The API returns an empty string for
+// unset fields, so an empty value is kept null when the attribute is not
+// set in the current state (e.g. after an import) to avoid a spurious diff.
+// An unknown current value is handled as a null one.
+func optionalStringValue(current types.String, value string) types.String {
+	if value == "" && (current.IsNull() || current.IsUnknown()) {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// timestampValue converts a timestamp in milliseconds since the epoch, as
+// returned by the API, into an RFC3339 string. Zero is kept null.
+func timestampValue(ms int64) types.String {
+	if ms == 0 {
+		return types.StringNull()
+	}
+	return types.StringValue(time.UnixMilli(ms).UTC().Format(time.RFC3339))
+}
+
+// optionalInt64Value is the types.Int64 counterpart of optionalStringValue.
+func optionalInt64Value(current types.Int64, value int64) types.Int64 {
+	if value == 0 && (current.IsNull() || current.IsUnknown()) {
+		return types.Int64Null()
+	}
+	return types.Int64Value(value)
+}
+
+// optionalFloat64Value is the types.Float64 counterpart of optionalStringValue.
+func optionalFloat64Value(current types.Float64, value float64) types.Float64 {
+	if value == 0 && (current.IsNull() || current.IsUnknown()) {
+		return types.Float64Null()
+	}
+	return types.Float64Value(value)
+}
+
+// optionalBoolValue is the types.Bool counterpart of optionalStringValue.
+func optionalBoolValue(current types.Bool, value bool) types.Bool {
+	if !value && (current.IsNull() || current.IsUnknown()) {
+		return types.BoolNull()
+	}
+	return types.BoolValue(value)
+}